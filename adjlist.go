@@ -0,0 +1,78 @@
+package ggraph
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ToAdjacencyList 将图渲染为紧凑的邻接表文本，可由ParseAdjacencyList解析
+// 按索引顺序每个节点一行，格式为"节点: 邻居1, 邻居2"，没有出边的节点输出为"节点:"，每行以换行结尾；
+// 节点取fmt.Sprintf("%v", node)，包含':'、','、换行或首尾空白的节点无法往返
+func (g *Graph[T]) ToAdjacencyList() string {
+	var builder strings.Builder
+	for idx, node := range g.indexToNode {
+		builder.WriteString(fmt.Sprintf("%v:", node))
+		for i, next := range g.adj[idx] {
+			if i == 0 {
+				builder.WriteString(" ")
+			} else {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(fmt.Sprintf("%v", g.indexToNode[next]))
+		}
+		builder.WriteString("\n")
+	}
+	return builder.String()
+}
+
+// ParseAdjacencyList 解析ToAdjacencyList格式的邻接表文本，创建字符串节点图
+// 每个非空行形如"节点: 邻居1, 邻居2"，冒号后可以为空；节点名两端空白会被去除，空行被忽略
+// 节点按声明行的顺序添加，只作为邻居出现的节点随后按首次出现的顺序添加；
+// 缺少冒号、节点名为空或同一节点重复声明时返回带行号的错误
+func ParseAdjacencyList(s string) (*Graph[string], error) {
+	type entry struct {
+		node      string
+		neighbors []string
+	}
+	var entries []entry
+	declared := make(map[string]bool)
+	for i, line := range strings.Split(s, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		head, tail, found := strings.Cut(line, ":")
+		if !found {
+			return nil, fmt.Errorf("ggraph: adjacency list line %d: missing ':'", i+1)
+		}
+		node := strings.TrimSpace(head)
+		if node == "" {
+			return nil, fmt.Errorf("ggraph: adjacency list line %d: empty node name", i+1)
+		}
+		if declared[node] {
+			return nil, fmt.Errorf("ggraph: adjacency list line %d: duplicate node %q", i+1, node)
+		}
+		declared[node] = true
+		var neighbors []string
+		if strings.TrimSpace(tail) != "" {
+			for _, field := range strings.Split(tail, ",") {
+				neighbor := strings.TrimSpace(field)
+				if neighbor == "" {
+					return nil, fmt.Errorf("ggraph: adjacency list line %d: empty neighbor name", i+1)
+				}
+				neighbors = append(neighbors, neighbor)
+			}
+		}
+		entries = append(entries, entry{node: node, neighbors: neighbors})
+	}
+
+	g := NewGraph[string]()
+	for _, e := range entries {
+		g.AddNode(e.node)
+	}
+	for _, e := range entries {
+		for _, neighbor := range e.neighbors {
+			g.AddEdge(e.node, neighbor)
+		}
+	}
+	return g, nil
+}
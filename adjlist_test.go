@@ -0,0 +1,55 @@
+package ggraph_test
+
+import (
+	"testing"
+
+	"github.com/nosusume/ggraph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdjacencyListRoundTrip(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddNode("A")
+	graph.AddNode("D")
+	graph.AddEdge("A", "B")
+	graph.AddEdge("A", "C")
+	graph.AddEdge("B", "C")
+	graph.AddEdge("A", "B")
+	graph.AddEdge("C", "C")
+
+	text := graph.ToAdjacencyList()
+	assert.Equal(t, "A: B, C, B\nD:\nB: C\nC: C\n", text, "渲染结果应符合文档中的格式")
+
+	parsed, err := ggraph.ParseAdjacencyList(text)
+	assert.NoError(t, err, "解析渲染结果不应出错")
+	assert.True(t, graph.Equal(parsed), "往返后图应相等")
+	assert.Equal(t, graph.Nodes(), parsed.Nodes(), "往返后节点顺序应保持不变")
+	assert.Equal(t, text, parsed.ToAdjacencyList(), "往返后渲染结果应一致")
+}
+
+func TestParseAdjacencyList(t *testing.T) {
+	parsed, err := ggraph.ParseAdjacencyList("\n  A :B,C \n\nB:   C\nC:")
+	assert.NoError(t, err, "解析不应出错")
+	assert.Equal(t, []string{"A", "B", "C"}, parsed.Nodes(), "节点应按声明顺序添加")
+	assert.Equal(t, []string{"B", "C"}, parsed.Neighbors("A"), "应去除名称两端的空白")
+	assert.True(t, parsed.HasEdge("B", "C"), "应解析B的邻居")
+
+	parsed, err = ggraph.ParseAdjacencyList("A: B")
+	assert.NoError(t, err, "未声明的邻居应被自动添加")
+	assert.True(t, parsed.HasNode("B"), "未声明的邻居应被自动添加")
+}
+
+func TestParseAdjacencyListMalformed(t *testing.T) {
+	cases := map[string]string{
+		"A: B\nB C\n":    "line 2: missing ':'",
+		"A: B\n\n : C\n": "line 3: empty node name",
+		"A: B,,C\n":      "line 1: empty neighbor name",
+		"A: B\nA: C\n":   "line 2: duplicate node",
+	}
+	for input, message := range cases {
+		_, err := ggraph.ParseAdjacencyList(input)
+		if assert.Error(t, err, "格式错误应返回错误: %q", input) {
+			assert.Contains(t, err.Error(), message, "错误应包含行号: %q", input)
+		}
+	}
+}
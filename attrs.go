@@ -0,0 +1,53 @@
+package ggraph
+
+// SetNodeAttr 为节点设置属性key的值，已存在时覆盖；节点不存在时自动添加
+// 节点被删除时其属性一并删除
+func (g *Graph[T]) SetNodeAttr(node T, key string, value any) {
+	g.mustBeMutable()
+	g.AddNode(node)
+	if g.nodeAttrs == nil {
+		g.nodeAttrs = make(map[T]map[string]any)
+	}
+	if g.nodeAttrs[node] == nil {
+		g.nodeAttrs[node] = make(map[string]any)
+	}
+	g.nodeAttrs[node][key] = value
+}
+
+// NodeAttr 返回节点属性key的值及其是否存在
+func (g *Graph[T]) NodeAttr(node T, key string) (any, bool) {
+	value, exists := g.nodeAttrs[node][key]
+	return value, exists
+}
+
+// SetEdgeAttr 为从from到to的边设置属性key的值，已存在时覆盖
+// 边不存在时返回ErrEdgeNotFound而不会自动创建边；同一节点对的平行边共享属性，
+// 无向图中同时设置两个方向；最后一条对应的边被删除时属性一并删除
+func (g *Graph[T]) SetEdgeAttr(from, to T, key string, value any) error {
+	g.mustBeMutable()
+	if !g.HasEdge(from, to) {
+		return ErrEdgeNotFound
+	}
+	g.setEdgeAttr(Edge[T]{From: from, To: to}, key, value)
+	if g.undirected && from != to {
+		g.setEdgeAttr(Edge[T]{From: to, To: from}, key, value)
+	}
+	return nil
+}
+
+// setEdgeAttr 设置边属性，不检查边是否存在
+func (g *Graph[T]) setEdgeAttr(edge Edge[T], key string, value any) {
+	if g.edgeAttrs == nil {
+		g.edgeAttrs = make(map[Edge[T]]map[string]any)
+	}
+	if g.edgeAttrs[edge] == nil {
+		g.edgeAttrs[edge] = make(map[string]any)
+	}
+	g.edgeAttrs[edge][key] = value
+}
+
+// EdgeAttr 返回从from到to的边的属性key的值及其是否存在
+func (g *Graph[T]) EdgeAttr(from, to T, key string) (any, bool) {
+	value, exists := g.edgeAttrs[Edge[T]{From: from, To: to}][key]
+	return value, exists
+}
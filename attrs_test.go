@@ -0,0 +1,93 @@
+package ggraph_test
+
+import (
+	"testing"
+
+	"github.com/nosusume/ggraph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodeAttr(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "B")
+
+	_, ok := graph.NodeAttr("A", "color")
+	assert.False(t, ok, "未设置的属性不应存在")
+
+	graph.SetNodeAttr("A", "color", "red")
+	value, ok := graph.NodeAttr("A", "color")
+	assert.True(t, ok, "已设置的属性应存在")
+	assert.Equal(t, "red", value, "属性值应为red")
+
+	graph.SetNodeAttr("A", "color", "blue")
+	value, _ = graph.NodeAttr("A", "color")
+	assert.Equal(t, "blue", value, "属性值应被覆盖")
+
+	graph.SetNodeAttr("C", "size", 3)
+	assert.True(t, graph.HasNode("C"), "为不存在的节点设置属性时应自动添加节点")
+
+	graph.RemoveNode("A")
+	graph.AddNode("A")
+	_, ok = graph.NodeAttr("A", "color")
+	assert.False(t, ok, "删除节点后其属性应被删除")
+}
+
+func TestNodeAttrDTO(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "B")
+	assert.Nil(t, graph.ToDTO().NodeAttrs, "没有节点属性时DTO应省略属性")
+
+	graph.SetNodeAttr("B", "label", "second")
+	dto := graph.ToDTO()
+	assert.Equal(t, []map[string]any{nil, {"label": "second"}}, dto.NodeAttrs, "节点属性应与Nodes逐项对应")
+
+	newGraph := ggraph.NewGraphByDTO(dto)
+	value, ok := newGraph.NodeAttr("B", "label")
+	assert.True(t, ok, "DTO恢复的图应包含节点属性")
+	assert.Equal(t, "second", value, "节点属性值应一致")
+
+	clone := graph.Clone()
+	clone.SetNodeAttr("B", "label", "changed")
+	value, _ = graph.NodeAttr("B", "label")
+	assert.Equal(t, "second", value, "修改副本的属性不应影响原图")
+}
+
+func TestEdgeAttr(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("A", "B") // 平行边
+
+	assert.ErrorIs(t, graph.SetEdgeAttr("B", "A", "label", "x"), ggraph.ErrEdgeNotFound, "为不存在的边设置属性应返回错误")
+	assert.False(t, graph.HasEdge("B", "A"), "不应自动创建边")
+
+	assert.NoError(t, graph.SetEdgeAttr("A", "B", "label", "road"), "为存在的边设置属性不应出错")
+	value, ok := graph.EdgeAttr("A", "B", "label")
+	assert.True(t, ok, "已设置的属性应存在")
+	assert.Equal(t, "road", value, "属性值应为road")
+	_, ok = graph.EdgeAttr("B", "A", "label")
+	assert.False(t, ok, "反向边不应共享属性")
+
+	graph.RemoveEdge("A", "B")
+	_, ok = graph.EdgeAttr("A", "B", "label")
+	assert.True(t, ok, "仍有平行边时属性应保留")
+	graph.RemoveEdge("A", "B")
+	_, ok = graph.EdgeAttr("A", "B", "label")
+	assert.False(t, ok, "边全部删除后属性应被清除")
+
+	graph.AddEdge("A", "B")
+	_, ok = graph.EdgeAttr("A", "B", "label")
+	assert.False(t, ok, "重新添加的边不应带有旧属性")
+}
+
+func TestEdgeAttrRemoveNode(t *testing.T) {
+	graph := ggraph.NewUndirectedGraph[int]()
+	graph.AddEdge(1, 2)
+	assert.NoError(t, graph.SetEdgeAttr(1, 2, "capacity", 5), "设置属性不应出错")
+	value, _ := graph.EdgeAttr(2, 1, "capacity")
+	assert.Equal(t, 5, value, "无向图中两个方向应同时设置属性")
+
+	graph.RemoveNode(2)
+	graph.AddEdge(1, 2)
+	_, ok := graph.EdgeAttr(1, 2, "capacity")
+	assert.False(t, ok, "删除节点后其关联边的属性应被清除")
+}
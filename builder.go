@@ -0,0 +1,39 @@
+package ggraph
+
+// GraphBuilder 以链式调用声明式地构建图，记录的操作在Build时按调用顺序依次执行
+type GraphBuilder[T comparable] struct {
+	ops []func(g *Graph[T])
+}
+
+// NewGraphBuilder 创建一个空的图构建器
+func NewGraphBuilder[T comparable]() *GraphBuilder[T] {
+	return &GraphBuilder[T]{}
+}
+
+// Node 记录添加节点的操作，等同于AddNode
+func (gb *GraphBuilder[T]) Node(node T) *GraphBuilder[T] {
+	gb.ops = append(gb.ops, func(g *Graph[T]) { g.AddNode(node) })
+	return gb
+}
+
+// Edge 记录添加从from到to的有向边的操作，等同于AddEdge
+func (gb *GraphBuilder[T]) Edge(from, to T) *GraphBuilder[T] {
+	gb.ops = append(gb.ops, func(g *Graph[T]) { g.AddEdge(from, to) })
+	return gb
+}
+
+// Undirected 记录添加a与b之间无向边的操作，等同于AddUndirectedEdge
+func (gb *GraphBuilder[T]) Undirected(a, b T) *GraphBuilder[T] {
+	gb.ops = append(gb.ops, func(g *Graph[T]) { g.AddUndirectedEdge(a, b) })
+	return gb
+}
+
+// Build 在新的有向图上依次执行所有记录的操作并返回该图
+// 构建器不会被消耗，可多次调用Build得到互相独立的图
+func (gb *GraphBuilder[T]) Build() *Graph[T] {
+	g := NewGraph[T]()
+	for _, op := range gb.ops {
+		op(g)
+	}
+	return g
+}
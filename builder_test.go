@@ -0,0 +1,32 @@
+package ggraph_test
+
+import (
+	"testing"
+
+	"github.com/nosusume/ggraph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraphBuilder(t *testing.T) {
+	builder := ggraph.NewGraphBuilder[string]().
+		Node("Z").
+		Edge("A", "B").
+		Edge("B", "C").
+		Undirected("C", "D").
+		Edge("A", "B")
+	built := builder.Build()
+
+	expected := ggraph.NewGraph[string]()
+	expected.AddNode("Z")
+	expected.AddEdge("A", "B")
+	expected.AddEdge("B", "C")
+	expected.AddUndirectedEdge("C", "D")
+	expected.AddEdge("A", "B")
+
+	assert.True(t, expected.Equal(built), "构建器生成的图应与直接构建的图相等")
+	assert.Equal(t, expected.Nodes(), built.Nodes(), "节点应按操作顺序添加")
+
+	built.AddEdge("D", "E")
+	assert.False(t, builder.Build().HasNode("E"), "多次Build应得到互相独立的图")
+	assert.True(t, ggraph.NewGraphBuilder[int]().Build().IsEmpty(), "空构建器应生成空图")
+}
@@ -0,0 +1,111 @@
+package ggraph
+
+// PageRank 使用幂迭代法计算有向图中每个节点的PageRank得分
+// damping为阻尼系数（通常取0.85），iterations为迭代次数；平行边按重数分配得分
+// 无出边的悬挂节点将其得分均匀分配给所有节点，因此得分之和约为1；空图返回空表
+func (g *Graph[T]) PageRank(damping float64, iterations int) map[T]float64 {
+	n := len(g.nodes)
+	scores := make(map[T]float64, n)
+	if n == 0 {
+		return scores
+	}
+	rank := make([]float64, n)
+	for i := range rank {
+		rank[i] = 1 / float64(n)
+	}
+	next := make([]float64, n)
+	for iter := 0; iter < iterations; iter++ {
+		dangling := 0.0
+		for from, neighbors := range g.adj {
+			if len(neighbors) == 0 {
+				dangling += rank[from]
+			}
+		}
+		base := (1-damping)/float64(n) + damping*dangling/float64(n)
+		for i := range next {
+			next[i] = base
+		}
+		for from, neighbors := range g.adj {
+			share := damping * rank[from] / float64(len(neighbors))
+			for _, to := range neighbors {
+				next[to] += share
+			}
+		}
+		rank, next = next, rank
+	}
+	for idx, score := range rank {
+		scores[g.indexToNode[idx]] = score
+	}
+	return scores
+}
+
+// BetweennessCentrality 使用Brandes算法计算无权有向图中每个节点的介数中心性
+// 返回未归一化的原始值：节点v的得分为所有有序节点对(s,t)（s≠v≠t）之间
+// 经过v的最短路径所占比例之和；时间复杂度O(V·E)
+func (g *Graph[T]) BetweennessCentrality() map[T]float64 {
+	n := len(g.nodes)
+	centrality := make([]float64, n)
+	dist := make([]int, n)
+	sigma := make([]float64, n)
+	delta := make([]float64, n)
+	preds := make([][]int, n)
+	for s := 0; s < n; s++ {
+		for i := range dist {
+			dist[i] = -1
+			sigma[i] = 0
+			delta[i] = 0
+			preds[i] = preds[i][:0]
+		}
+		dist[s] = 0
+		sigma[s] = 1
+		// order记录按距离非递减的出队顺序
+		order := make([]int, 0, n)
+		queue := []int{s}
+		for len(queue) > 0 {
+			v := queue[0]
+			queue = queue[1:]
+			order = append(order, v)
+			for _, w := range g.adj[v] {
+				if dist[w] == -1 {
+					dist[w] = dist[v] + 1
+					queue = append(queue, w)
+				}
+				if dist[w] == dist[v]+1 {
+					sigma[w] += sigma[v]
+					preds[w] = append(preds[w], v)
+				}
+			}
+		}
+		// 按距离从远到近累加依赖值
+		for i := len(order) - 1; i >= 0; i-- {
+			w := order[i]
+			for _, v := range preds[w] {
+				delta[v] += sigma[v] / sigma[w] * (1 + delta[w])
+			}
+			if w != s {
+				centrality[w] += delta[w]
+			}
+		}
+	}
+	scores := make(map[T]float64, n)
+	for idx, score := range centrality {
+		scores[g.indexToNode[idx]] = score
+	}
+	return scores
+}
+
+// DegreeCentrality 返回每个节点的度中心性，即(入度+出度)/(N-1)
+// 平行边按重数计，自环同时计入入度和出度；节点数不超过1时所有得分为0
+func (g *Graph[T]) DegreeCentrality() map[T]float64 {
+	n := len(g.nodes)
+	scores := make(map[T]float64, n)
+	inDegree := g.inDegrees()
+	for idx, node := range g.indexToNode {
+		if n <= 1 {
+			scores[node] = 0
+			continue
+		}
+		scores[node] = float64(inDegree[idx]+len(g.adj[idx])) / float64(n-1)
+	}
+	return scores
+}
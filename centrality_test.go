@@ -0,0 +1,77 @@
+package ggraph_test
+
+import (
+	"testing"
+
+	"github.com/nosusume/ggraph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPageRank(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "C")
+	graph.AddEdge("B", "C")
+	graph.AddEdge("D", "C")
+	graph.AddEdge("C", "A")
+	graph.AddEdge("A", "B")
+	graph.AddNode("E") // 悬挂节点
+
+	scores := graph.PageRank(0.85, 50)
+	assert.Len(t, scores, graph.NodeCount(), "每个节点都应有得分")
+	sum := 0.0
+	for node, score := range scores {
+		sum += score
+		if node != "C" {
+			assert.Greater(t, scores["C"], score, "入链最多的C得分应最高")
+		}
+	}
+	assert.InDelta(t, 1.0, sum, 1e-9, "得分之和应约为1")
+	assert.Empty(t, ggraph.NewGraph[int]().PageRank(0.85, 10), "空图应返回空表")
+}
+
+func TestBetweennessCentrality(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	// 两个簇通过中心节点H相连
+	for _, pair := range [][2]string{{"A", "B"}, {"A", "H"}, {"B", "H"}, {"H", "C"}, {"H", "D"}, {"C", "D"}} {
+		graph.AddUndirectedEdge(pair[0], pair[1])
+	}
+
+	scores := graph.BetweennessCentrality()
+	for node, score := range scores {
+		if node != "H" {
+			assert.Greater(t, scores["H"], score, "桥接节点H得分应最高")
+		}
+	}
+	assert.Equal(t, 8.0, scores["H"], "H应位于两个簇之间的8条有序最短路径上")
+	assert.Zero(t, scores["A"], "A不位于任何其他节点对的最短路径上")
+}
+
+func TestBetweennessCentralityChain(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	graph.AddEdge(1, 2)
+	graph.AddEdge(2, 3)
+	graph.AddEdge(1, 4)
+	graph.AddEdge(4, 3)
+
+	scores := graph.BetweennessCentrality()
+	assert.Equal(t, 0.5, scores[2], "两条等长最短路径应各分得一半")
+	assert.Equal(t, 0.5, scores[4], "两条等长最短路径应各分得一半")
+	assert.Zero(t, scores[1], "端点不应有介数")
+}
+
+func TestDegreeCentrality(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	for leaf := 1; leaf <= 4; leaf++ {
+		graph.AddEdge(0, leaf)
+	}
+
+	scores := graph.DegreeCentrality()
+	assert.Equal(t, 1.0, scores[0], "星形图中心节点的度中心性应为1")
+	for leaf := 1; leaf <= 4; leaf++ {
+		assert.Equal(t, 0.25, scores[leaf], "叶子节点的度中心性应为1/(N-1)")
+	}
+
+	single := ggraph.NewGraph[int]()
+	single.AddNode(1)
+	assert.Equal(t, map[int]float64{1: 0}, single.DegreeCentrality(), "单节点图应返回0")
+}
@@ -0,0 +1,70 @@
+package ggraph
+
+// IsBipartite 将边视为无向边，尝试对图进行二着色
+// 返回图是否为二部图，以及为二部图时每个节点的颜色（0或1）；不是二部图时颜色表为nil
+// 每个连通分量中索引最小的节点着色为0；自环使图不是二部图
+func (g *Graph[T]) IsBipartite() (bool, map[T]int) {
+	undirected := g.undirectedAdj()
+	color := make([]int, len(g.nodes))
+	for i := range color {
+		color[i] = -1
+	}
+	for start := range color {
+		if color[start] != -1 {
+			continue
+		}
+		color[start] = 0
+		queue := []int{start}
+		for len(queue) > 0 {
+			current := queue[0]
+			queue = queue[1:]
+			for _, next := range undirected[current] {
+				if color[next] == -1 {
+					color[next] = 1 - color[current]
+					queue = append(queue, next)
+				} else if color[next] == color[current] {
+					return false, nil
+				}
+			}
+		}
+	}
+	colors := make(map[T]int, len(color))
+	for idx, c := range color {
+		colors[g.indexToNode[idx]] = c
+	}
+	return true, colors
+}
+
+// GreedyColoring 将边视为无向边，按索引顺序使用贪心算法为节点着色
+// 每个节点取其已着色邻居未使用的最小颜色（从0开始），使相邻节点颜色不同
+// 使用的颜色数为最大颜色值加1；结果不保证颜色数最少，自环被忽略
+func (g *Graph[T]) GreedyColoring() map[T]int {
+	undirected := g.undirectedAdj()
+	color := make([]int, len(g.nodes))
+	for i := range color {
+		color[i] = -1
+	}
+	used := make([]bool, len(g.nodes)+1)
+	for idx := range color {
+		for _, next := range undirected[idx] {
+			if color[next] >= 0 {
+				used[color[next]] = true
+			}
+		}
+		c := 0
+		for used[c] {
+			c++
+		}
+		color[idx] = c
+		for _, next := range undirected[idx] {
+			if color[next] >= 0 {
+				used[color[next]] = false
+			}
+		}
+	}
+	colors := make(map[T]int, len(color))
+	for idx, c := range color {
+		colors[g.indexToNode[idx]] = c
+	}
+	return colors
+}
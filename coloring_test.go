@@ -0,0 +1,74 @@
+package ggraph_test
+
+import (
+	"testing"
+
+	"github.com/nosusume/ggraph"
+	"github.com/stretchr/testify/assert"
+)
+
+// newCycleGraph 构建节点为0..n-1的有向环
+func newCycleGraph(n int) *ggraph.Graph[int] {
+	graph := ggraph.NewGraph[int]()
+	for i := 0; i < n; i++ {
+		graph.AddEdge(i, (i+1)%n)
+	}
+	return graph
+}
+
+func TestIsBipartiteEvenCycle(t *testing.T) {
+	graph := newCycleGraph(6)
+
+	ok, colors := graph.IsBipartite()
+	assert.True(t, ok, "偶环应为二部图")
+	for _, edge := range graph.Edges() {
+		assert.NotEqual(t, colors[edge.From], colors[edge.To], "相邻节点%d和%d颜色应不同", edge.From, edge.To)
+	}
+	assert.Equal(t, 0, colors[0], "首个节点应着色为0")
+}
+
+func TestIsBipartiteOddCycle(t *testing.T) {
+	ok, colors := newCycleGraph(5).IsBipartite()
+	assert.False(t, ok, "奇环不应为二部图")
+	assert.Nil(t, colors, "不是二部图时颜色表应为nil")
+}
+
+func TestIsBipartiteDisconnected(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("C", "B")
+	// 另一个连通分量为三角形
+	graph.AddEdge("X", "Y")
+	graph.AddEdge("Y", "Z")
+	graph.AddEdge("Z", "X")
+
+	ok, _ := graph.IsBipartite()
+	assert.False(t, ok, "任一连通分量不是二部图时整体不是二部图")
+
+	graph.RemoveNode("Z")
+	ok, colors := graph.IsBipartite()
+	assert.True(t, ok, "所有连通分量均为二部图时整体为二部图")
+	assert.Len(t, colors, 5, "每个节点都应着色")
+}
+
+func TestGreedyColoring(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", "C")
+	graph.AddEdge("C", "A")
+	graph.AddEdge("C", "D")
+	graph.AddEdge("D", "E")
+	graph.AddNode("F")
+
+	colors := graph.GreedyColoring()
+	assert.Len(t, colors, graph.NodeCount(), "每个节点都应着色")
+	for _, edge := range graph.Edges() {
+		assert.NotEqual(t, colors[edge.From], colors[edge.To], "相邻节点%s和%s颜色应不同", edge.From, edge.To)
+	}
+	maxColor := 0
+	for _, c := range colors {
+		maxColor = max(maxColor, c)
+	}
+	assert.Equal(t, 2, maxColor, "三角形应使用3种颜色")
+	assert.Equal(t, 0, colors["F"], "孤立节点应着色为0")
+}
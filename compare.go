@@ -0,0 +1,66 @@
+package ggraph
+
+// Equal 判断两个图是否具有相同的节点集合和边多重集合，与插入顺序和内部索引无关
+// 平行边按多重集合比较（两条A->B只与两条A->B相等）；边权重不参与比较
+func (g *Graph[T]) Equal(other *Graph[T]) bool {
+	if len(g.nodes) != len(other.nodes) || g.EdgeCount() != other.EdgeCount() {
+		return false
+	}
+	for node := range g.nodes {
+		if !other.HasNode(node) {
+			return false
+		}
+	}
+	counts := g.edgeCounts()
+	for edge := range other.AllEdges() {
+		if counts[edge] == 0 {
+			return false
+		}
+		counts[edge]--
+	}
+	return true
+}
+
+// Diff 返回从a变化到b的结构差异：b中新增与删除的节点，以及新增与删除的边
+// 比较与插入顺序无关，平行边按多重集合计算（a中一条A->B、b中三条时新增两条A->B），边权重不参与比较；
+// 新增的节点和边按b中的顺序排列，删除的按a中的顺序排列
+func Diff[T comparable](a, b *Graph[T]) (addedNodes, removedNodes []T, addedEdges, removedEdges []Edge[T]) {
+	addedNodes, removedNodes = make([]T, 0), make([]T, 0)
+	addedEdges, removedEdges = make([]Edge[T], 0), make([]Edge[T], 0)
+	for node := range b.AllNodes() {
+		if !a.HasNode(node) {
+			addedNodes = append(addedNodes, node)
+		}
+	}
+	for node := range a.AllNodes() {
+		if !b.HasNode(node) {
+			removedNodes = append(removedNodes, node)
+		}
+	}
+	remaining := a.edgeCounts()
+	for edge := range b.AllEdges() {
+		if remaining[edge] > 0 {
+			remaining[edge]--
+		} else {
+			addedEdges = append(addedEdges, edge)
+		}
+	}
+	remaining = b.edgeCounts()
+	for edge := range a.AllEdges() {
+		if remaining[edge] > 0 {
+			remaining[edge]--
+		} else {
+			removedEdges = append(removedEdges, edge)
+		}
+	}
+	return addedNodes, removedNodes, addedEdges, removedEdges
+}
+
+// edgeCounts 返回每条边（按起止节点）出现的次数
+func (g *Graph[T]) edgeCounts() map[Edge[T]]int {
+	counts := make(map[Edge[T]]int)
+	for edge := range g.AllEdges() {
+		counts[edge]++
+	}
+	return counts
+}
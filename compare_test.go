@@ -0,0 +1,78 @@
+package ggraph_test
+
+import (
+	"testing"
+
+	"github.com/nosusume/ggraph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEqual(t *testing.T) {
+	a := ggraph.NewGraph[string]()
+	a.AddNode("D")
+	a.AddEdge("A", "B")
+	a.AddEdge("B", "C")
+	a.AddEdge("A", "B")
+
+	b := ggraph.NewGraph[string]()
+	b.AddEdge("B", "C")
+	b.AddEdge("A", "B")
+	b.AddEdge("A", "B")
+	b.AddNode("D")
+
+	assert.True(t, a.Equal(b), "插入顺序不同的相同图应相等")
+	assert.True(t, b.Equal(a), "相等关系应对称")
+	assert.True(t, a.Equal(a.Clone()), "副本应与原图相等")
+}
+
+func TestEqualMultiset(t *testing.T) {
+	a := ggraph.NewGraph[int]()
+	a.AddEdge(1, 2)
+	a.AddEdge(1, 2)
+
+	b := ggraph.NewGraph[int]()
+	b.AddEdge(1, 2)
+	b.AddEdge(2, 1)
+	assert.False(t, a.Equal(b), "边多重集合不同时不应相等")
+
+	c := ggraph.NewGraph[int]()
+	c.AddEdge(1, 2)
+	assert.False(t, a.Equal(c), "平行边数量不同时不应相等")
+
+	d := a.Clone()
+	d.AddNode(3)
+	assert.False(t, a.Equal(d), "节点集合不同时不应相等")
+}
+
+func TestDiff(t *testing.T) {
+	before := ggraph.NewGraph[string]()
+	before.AddEdge("A", "B")
+	before.AddEdge("B", "C")
+	before.AddEdge("C", "D")
+
+	after := ggraph.NewGraph[string]()
+	after.AddEdge("B", "C")
+	after.AddEdge("A", "B")
+	after.AddEdge("C", "A")
+	after.AddNode("D")
+	after.RemoveNode("D")
+	after.AddEdge("A", "B") // 平行边按多重集合计算
+
+	addedNodes, removedNodes, addedEdges, removedEdges := ggraph.Diff(before, after)
+	assert.Empty(t, addedNodes, "不应有新增节点")
+	assert.Equal(t, []string{"D"}, removedNodes, "应报告删除的节点D")
+	assert.Equal(t, []ggraph.Edge[string]{{From: "C", To: "A"}, {From: "A", To: "B"}}, addedEdges, "应报告新增的两条边")
+	assert.Equal(t, []ggraph.Edge[string]{{From: "C", To: "D"}}, removedEdges, "应报告删除的边")
+
+	addedNodes, removedNodes, addedEdges, removedEdges = ggraph.Diff(after, before)
+	assert.Equal(t, []string{"D"}, addedNodes, "反向比较时D应为新增节点")
+	assert.Empty(t, removedNodes, "反向比较时不应有删除节点")
+	assert.Len(t, addedEdges, 1, "反向比较时应新增一条边")
+	assert.Len(t, removedEdges, 2, "反向比较时应删除两条边")
+
+	addedNodes, removedNodes, addedEdges, removedEdges = ggraph.Diff(before, before.Clone())
+	assert.Empty(t, addedNodes, "相同的图不应有差异")
+	assert.Empty(t, removedNodes, "相同的图不应有差异")
+	assert.Empty(t, addedEdges, "相同的图不应有差异")
+	assert.Empty(t, removedEdges, "相同的图不应有差异")
+}
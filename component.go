@@ -0,0 +1,201 @@
+package ggraph
+
+import "slices"
+
+// StronglyConnectedComponents 使用Tarjan算法返回有向图的强连通分量
+// 每个节点恰好属于一个分量（孤立节点自成一个分量）
+// 分量按逆拓扑序排列（即Tarjan算法的自然输出顺序：被依赖的分量在前），
+// 分量内节点按索引顺序排列
+func (g *Graph[T]) StronglyConnectedComponents() [][]T {
+	components := make([][]T, 0)
+	for _, members := range g.tarjan() {
+		component := make([]T, len(members))
+		for i, idx := range members {
+			component[i] = g.indexToNode[idx]
+		}
+		components = append(components, component)
+	}
+	return components
+}
+
+// Condensation 将每个强连通分量收缩为一个节点，返回收缩后的有向无环图及分量编号到成员节点的映射
+// 分量编号与StronglyConnectedComponents的返回顺序一致；分量之间至多保留一条边，结果图总是无环的
+func (g *Graph[T]) Condensation() (*Graph[int], [][]T) {
+	components := g.tarjan()
+	componentOf := make([]int, len(g.nodes))
+	condensed := NewGraph[int]()
+	mapping := make([][]T, len(components))
+	for id, members := range components {
+		condensed.AddNode(id)
+		for _, idx := range members {
+			componentOf[idx] = id
+		}
+		mapping[id] = g.toNodes(members)
+	}
+	for from, neighbors := range g.adj {
+		for _, to := range neighbors {
+			cf, ct := componentOf[from], componentOf[to]
+			if cf != ct && !condensed.HasEdge(cf, ct) {
+				condensed.AddEdge(cf, ct)
+			}
+		}
+	}
+	return condensed, mapping
+}
+
+// tarjan 迭代实现的Tarjan算法，返回按逆拓扑序排列的分量索引列表，分量内索引升序
+func (g *Graph[T]) tarjan() [][]int {
+	n := len(g.nodes)
+	order := make([]int, n) // 发现次序，0表示未访问
+	low := make([]int, n)
+	onStack := make([]bool, n)
+	stack := make([]int, 0)
+	components := make([][]int, 0)
+	counter := 0
+
+	for root := 0; root < n; root++ {
+		if order[root] != 0 {
+			continue
+		}
+		counter++
+		order[root], low[root] = counter, counter
+		stack = append(stack, root)
+		onStack[root] = true
+		frames := []dfsFrame{{index: root}}
+		for len(frames) > 0 {
+			top := &frames[len(frames)-1]
+			v := top.index
+			if top.next < len(g.adj[v]) {
+				w := g.adj[v][top.next]
+				top.next++
+				if order[w] == 0 {
+					counter++
+					order[w], low[w] = counter, counter
+					stack = append(stack, w)
+					onStack[w] = true
+					frames = append(frames, dfsFrame{index: w})
+				} else if onStack[w] {
+					low[v] = min(low[v], order[w])
+				}
+				continue
+			}
+			frames = frames[:len(frames)-1]
+			if len(frames) > 0 {
+				parent := frames[len(frames)-1].index
+				low[parent] = min(low[parent], low[v])
+			}
+			if low[v] != order[v] {
+				continue
+			}
+			// v为分量的根，弹出整个分量
+			var component []int
+			for {
+				w := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[w] = false
+				component = append(component, w)
+				if w == v {
+					break
+				}
+			}
+			slices.Sort(component)
+			components = append(components, component)
+		}
+	}
+	return components
+}
+
+// ConnectedComponents 将边视为无向边，返回相互可达的节点分组
+// 孤立节点自成一个分量；分量按其最小节点索引排序，分量内节点按索引顺序排列
+func (g *Graph[T]) ConnectedComponents() [][]T {
+	undirected := g.undirectedAdj()
+	visited := make([]bool, len(g.nodes))
+	components := make([][]T, 0)
+	for start := range visited {
+		if visited[start] {
+			continue
+		}
+		visited[start] = true
+		members := []int{start}
+		for i := 0; i < len(members); i++ {
+			for _, next := range undirected[members[i]] {
+				if !visited[next] {
+					visited[next] = true
+					members = append(members, next)
+				}
+			}
+		}
+		slices.Sort(members)
+		component := make([]T, len(members))
+		for i, idx := range members {
+			component[i] = g.indexToNode[idx]
+		}
+		components = append(components, component)
+	}
+	return components
+}
+
+// ComponentCount 使用并查集返回弱连通分量（将边视为无向边）的数量，不构建分量切片
+// 空图返回0，每个孤立节点计为一个分量
+func (g *Graph[T]) ComponentCount() int {
+	return g.weakComponents().count
+}
+
+// AreWeaklyConnected 返回a与b是否属于同一个弱连通分量（将边视为无向边），不构建分量切片
+// 使用并查集合并所有边的端点；a与b相同且存在时返回true，任一节点不存在时返回false
+func (g *Graph[T]) AreWeaklyConnected(a, b T) bool {
+	aIndex, aExists := g.nodes[a]
+	bIndex, bExists := g.nodes[b]
+	if !aExists || !bExists {
+		return false
+	}
+	ds := g.weakComponents()
+	return ds.find(aIndex) == ds.find(bIndex)
+}
+
+// IsTree 返回将图视为无向图时是否为一棵树，即连通且恰有N-1条边（从而无环）
+// 有向图中每条有向边计为一条无向边，因此A->B与B->A构成环；无向图模式下每条无向边只计一次；
+// 空图不是树，单个孤立节点是树；自环和平行边总会使结果为false
+func (g *Graph[T]) IsTree() bool {
+	n := len(g.nodes)
+	if n == 0 {
+		return false
+	}
+	edges := g.EdgeCount()
+	if g.undirected {
+		// 无向图中非自环的边以两条有向边存储
+		for idx, neighbors := range g.adj {
+			if slices.Contains(neighbors, idx) {
+				return false
+			}
+		}
+		edges /= 2
+	}
+	return edges == n-1 && g.ComponentCount() == 1
+}
+
+// weakComponents 返回按弱连通性合并了所有边端点的并查集
+func (g *Graph[T]) weakComponents() *disjointSet {
+	ds := newDisjointSet(len(g.nodes))
+	for from, neighbors := range g.adj {
+		for _, to := range neighbors {
+			ds.union(from, to)
+		}
+	}
+	return ds
+}
+
+// undirectedAdj 返回将每条边视为双向后的邻接表
+// 出边在前、入边在后，可能包含重复邻居
+func (g *Graph[T]) undirectedAdj() [][]int {
+	undirected := make([][]int, len(g.nodes))
+	for from := range undirected {
+		undirected[from] = append(undirected[from], g.adj[from]...)
+	}
+	for from := range undirected {
+		for _, to := range g.adj[from] {
+			undirected[to] = append(undirected[to], from)
+		}
+	}
+	return undirected
+}
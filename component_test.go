@@ -0,0 +1,147 @@
+package ggraph_test
+
+import (
+	"testing"
+
+	"github.com/nosusume/ggraph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	// 分量{A,B,C}，其中嵌套了环A->B->A与A->B->C->A
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", "A")
+	graph.AddEdge("B", "C")
+	graph.AddEdge("C", "A")
+	// 分量{D,E}
+	graph.AddEdge("C", "D")
+	graph.AddEdge("D", "E")
+	graph.AddEdge("E", "D")
+	// 单节点分量
+	graph.AddEdge("E", "F")
+
+	components := graph.StronglyConnectedComponents()
+	assert.Equal(t, [][]string{{"F"}, {"D", "E"}, {"A", "B", "C"}}, components, "应按逆拓扑序返回强连通分量")
+}
+
+func TestStronglyConnectedComponentsSingle(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	for i := 0; i < 100; i++ {
+		graph.AddEdge(i, (i+1)%100)
+	}
+
+	components := graph.StronglyConnectedComponents()
+	assert.Len(t, components, 1, "大环应构成单个强连通分量")
+	assert.Len(t, components[0], 100, "分量应包含全部节点")
+}
+
+func TestStronglyConnectedComponentsSingletons(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	graph.AddEdge(1, 2)
+	graph.AddNode(3)
+
+	components := graph.StronglyConnectedComponents()
+	assert.ElementsMatch(t, [][]int{{1}, {2}, {3}}, components, "无环图中每个节点自成一个分量")
+}
+
+func TestCondensation(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", "A")
+	graph.AddEdge("B", "C")
+	graph.AddEdge("A", "C")
+	graph.AddEdge("C", "D")
+	graph.AddEdge("D", "C")
+
+	condensed, members := graph.Condensation()
+	assert.Equal(t, [][]string{{"C", "D"}, {"A", "B"}}, members, "分量映射应与StronglyConnectedComponents一致")
+	assert.Equal(t, 2, condensed.NodeCount(), "应收缩为两个节点")
+	assert.Equal(t, 1, condensed.EdgeCount(), "分量间的多条边应合并为一条")
+	assert.True(t, condensed.HasEdge(1, 0), "应存在从{A,B}到{C,D}的边")
+	assert.True(t, condensed.IsDAG(), "收缩图应无环")
+}
+
+func TestCondensationSingleCycle(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	for i := 0; i < 10; i++ {
+		graph.AddEdge(i, (i+1)%10)
+	}
+
+	condensed, members := graph.Condensation()
+	assert.Equal(t, 1, condensed.NodeCount(), "单个大环应收缩为一个节点")
+	assert.Zero(t, condensed.EdgeCount(), "收缩图应没有边")
+	assert.Len(t, members[0], 10, "分量应包含全部节点")
+}
+
+func TestConnectedComponents(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("C", "B") // 方向相反也应连通
+	graph.AddEdge("D", "E")
+	graph.AddEdge("E", "F")
+	graph.AddNode("G")
+
+	components := graph.ConnectedComponents()
+	assert.Equal(t, [][]string{{"A", "B", "C"}, {"D", "E", "F"}, {"G"}}, components, "应返回两个簇及一个孤立节点")
+}
+
+func TestComponentCount(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("C", "B")
+	graph.AddEdge("D", "E")
+	graph.AddEdge("E", "F")
+	graph.AddNode("G")
+
+	assert.Equal(t, 3, graph.ComponentCount(), "两个簇加一个孤立节点应为3个分量")
+	assert.Equal(t, len(graph.ConnectedComponents()), graph.ComponentCount(), "应与ConnectedComponents一致")
+	assert.Zero(t, ggraph.NewGraph[int]().ComponentCount(), "空图应为0个分量")
+}
+
+func TestIsTree(t *testing.T) {
+	tree := ggraph.NewGraph[string]()
+	tree.AddEdge("root", "a")
+	tree.AddEdge("root", "b")
+	tree.AddEdge("c", "a") // 方向不影响判断
+	assert.True(t, tree.IsTree(), "连通且边数为N-1的图应为树")
+
+	forest := tree.Clone()
+	forest.AddEdge("x", "y")
+	assert.False(t, forest.IsTree(), "森林不是树")
+
+	cyclic := tree.Clone()
+	cyclic.AddEdge("a", "b")
+	assert.False(t, cyclic.IsTree(), "含环的图不是树")
+
+	pair := ggraph.NewGraph[string]()
+	pair.AddEdge("A", "B")
+	assert.True(t, pair.IsTree(), "单条边连接的两个节点是树")
+	pair.AddEdge("B", "A")
+	assert.False(t, pair.IsTree(), "A->B与B->A视为无向环")
+
+	undirected := ggraph.NewUndirectedGraph[int]()
+	undirected.AddEdge(1, 2)
+	undirected.AddEdge(2, 3)
+	assert.True(t, undirected.IsTree(), "无向图中每条无向边只计一次")
+	undirected.AddEdge(3, 3)
+	assert.False(t, undirected.IsTree(), "自环使图不是树")
+
+	single := ggraph.NewGraph[int]()
+	assert.False(t, single.IsTree(), "空图不是树")
+	single.AddNode(1)
+	assert.True(t, single.IsTree(), "单个节点是树")
+}
+
+func TestAreWeaklyConnected(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("B", "A") // 只有反方向的边
+	graph.AddEdge("C", "B")
+	graph.AddEdge("D", "E")
+
+	assert.True(t, graph.AreWeaklyConnected("A", "B"), "仅由反向边相连的节点应弱连通")
+	assert.True(t, graph.AreWeaklyConnected("A", "C"), "弱连通应具有传递性")
+	assert.False(t, graph.AreWeaklyConnected("A", "D"), "不同分量的节点不应弱连通")
+	assert.True(t, graph.AreWeaklyConnected("A", "A"), "节点与自身弱连通")
+	assert.False(t, graph.AreWeaklyConnected("A", "X"), "节点不存在时应返回false")
+}
@@ -0,0 +1,52 @@
+package ggraph
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteEdgeCSV 将图的边列表以CSV格式写入w
+// 首行为表头from,to，之后每条边一行，节点取fmt.Sprintf("%v", node)；孤立节点不会被写出
+func (g *Graph[T]) WriteEdgeCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"from", "to"}); err != nil {
+		return err
+	}
+	for edge := range g.AllEdges() {
+		if err := writer.Write([]string{fmt.Sprint(edge.From), fmt.Sprint(edge.To)}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// NewGraphFromEdgeCSV 从from,to格式的CSV边列表创建字符串节点图
+// 首行为from,to表头（不区分大小写）时跳过；字段两端空白会被去除
+// 列数不为2的行返回带行号的错误
+func NewGraphFromEdgeCSV(r io.Reader) (*Graph[string], error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	g := NewGraph[string]()
+	for first := true; ; first = false {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return g, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		if len(record) != 2 {
+			return nil, fmt.Errorf("ggraph: csv line %d: expected 2 columns, got %d", line, len(record))
+		}
+		from, to := strings.TrimSpace(record[0]), strings.TrimSpace(record[1])
+		if first && strings.EqualFold(from, "from") && strings.EqualFold(to, "to") {
+			continue
+		}
+		g.AddEdge(from, to)
+	}
+}
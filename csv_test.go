@@ -0,0 +1,38 @@
+package ggraph_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/nosusume/ggraph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEdgeCSVRoundTrip(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", "C, Inc.")
+	graph.AddEdge("C, Inc.", "A")
+
+	var buf bytes.Buffer
+	assert.NoError(t, graph.WriteEdgeCSV(&buf), "写出CSV不应出错")
+	assert.True(t, strings.HasPrefix(buf.String(), "from,to\n"), "首行应为表头")
+
+	newGraph, err := ggraph.NewGraphFromEdgeCSV(&buf)
+	assert.NoError(t, err, "读取CSV不应出错")
+	assert.ElementsMatch(t, graph.Edges(), newGraph.Edges(), "往返后边集合应一致")
+}
+
+func TestNewGraphFromEdgeCSVTrim(t *testing.T) {
+	graph, err := ggraph.NewGraphFromEdgeCSV(strings.NewReader(" A , B \nB,C\n"))
+	assert.NoError(t, err, "读取CSV不应出错")
+	assert.True(t, graph.HasEdge("A", "B"), "字段两端空白应被去除")
+	assert.True(t, graph.HasEdge("B", "C"), "无表头时首行应作为边")
+}
+
+func TestNewGraphFromEdgeCSVMalformed(t *testing.T) {
+	graph, err := ggraph.NewGraphFromEdgeCSV(strings.NewReader("from,to\nA,B\nB,C,D\n"))
+	assert.Nil(t, graph, "格式错误时不应返回图")
+	assert.ErrorContains(t, err, "line 3", "错误信息应包含行号")
+}
@@ -0,0 +1,93 @@
+package ggraph
+
+import "slices"
+
+// ArticulationPoints 将边视为无向边，按索引顺序返回割点（删除后连通分量数增加的节点）
+// 使用DFS low-link算法，支持不连通的图；平行边、反向边视为同一条无向边，自环被忽略
+func (g *Graph[T]) ArticulationPoints() []T {
+	isCut := make([]bool, len(g.nodes))
+	rootChildren := make([]int, len(g.nodes))
+	g.lowLink(func(parent, child int, parentIsRoot bool, disc, low []int) {
+		if parentIsRoot {
+			rootChildren[parent]++
+			// 根节点有两个及以上DFS子树时为割点
+			isCut[parent] = rootChildren[parent] > 1
+		} else if low[child] >= disc[parent] {
+			isCut[parent] = true
+		}
+	})
+	return g.markedNodes(isCut)
+}
+
+// Bridges 将边视为无向边，返回桥（删除后连通分量数增加的边）
+// 每条桥只返回一次，方向取图中实际存在的方向；平行边、反向边视为同一条无向边，
+// 因此同时存在A->B和B->A时它们作为一条无向边参与判断；结果按DFS完成顺序排列
+func (g *Graph[T]) Bridges() []Edge[T] {
+	bridges := make([]Edge[T], 0)
+	g.lowLink(func(parent, child int, _ bool, disc, low []int) {
+		if low[child] <= disc[parent] {
+			return
+		}
+		from, to := g.indexToNode[parent], g.indexToNode[child]
+		if !slices.Contains(g.adj[parent], child) {
+			from, to = to, from
+		}
+		bridges = append(bridges, Edge[T]{From: from, To: to})
+	})
+	return bridges
+}
+
+// simpleUndirectedAdj 返回将图视为简单无向图后的邻接表
+// 每个邻居只出现一次并按索引升序排列，自环被忽略
+func (g *Graph[T]) simpleUndirectedAdj() [][]int {
+	undirected := g.undirectedAdj()
+	for idx, neighbors := range undirected {
+		slices.Sort(neighbors)
+		neighbors = slices.Compact(neighbors)
+		if pos, found := slices.BinarySearch(neighbors, idx); found {
+			neighbors = slices.Delete(neighbors, pos, pos+1)
+		}
+		undirected[idx] = neighbors
+	}
+	return undirected
+}
+
+// lowLink 在简单无向视图上执行迭代DFS，计算每个节点的发现序disc与low值
+// 每条树边(parent, child)的子树处理完毕时调用onTreeEdge，parentIsRoot表示parent是否为DFS树的根
+func (g *Graph[T]) lowLink(onTreeEdge func(parent, child int, parentIsRoot bool, disc, low []int)) {
+	undirected := g.simpleUndirectedAdj()
+	disc := make([]int, len(g.nodes)) // 发现序，0表示未访问
+	low := make([]int, len(g.nodes))
+	counter := 0
+	for root := range undirected {
+		if disc[root] != 0 {
+			continue
+		}
+		counter++
+		disc[root], low[root] = counter, counter
+		stack := []dfsFrame{{index: root}}
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			v := top.index
+			if top.next < len(undirected[v]) {
+				w := undirected[v][top.next]
+				top.next++
+				if disc[w] == 0 {
+					counter++
+					disc[w], low[w] = counter, counter
+					stack = append(stack, dfsFrame{index: w})
+				} else if len(stack) < 2 || w != stack[len(stack)-2].index {
+					// 非树边（跳过指回父节点的边）
+					low[v] = min(low[v], disc[w])
+				}
+				continue
+			}
+			stack = stack[:len(stack)-1]
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1].index
+				low[parent] = min(low[parent], low[v])
+				onTreeEdge(parent, v, len(stack) == 1, disc, low)
+			}
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package ggraph_test
+
+import (
+	"testing"
+
+	"github.com/nosusume/ggraph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArticulationPoints(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	// 两个三角形通过割点C相连
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", "C")
+	graph.AddEdge("C", "A")
+	graph.AddEdge("C", "D")
+	graph.AddEdge("D", "E")
+	graph.AddEdge("E", "C")
+
+	assert.Equal(t, []string{"C"}, graph.ArticulationPoints(), "C应为唯一的割点")
+}
+
+func TestArticulationPointsDisconnected(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	// 链1-2-3，2为割点
+	graph.AddEdge(1, 2)
+	graph.AddEdge(3, 2)
+	// 另一连通分量：4-5-6，以5为根的DFS树有两个子树
+	graph.AddUndirectedEdge(5, 4)
+	graph.AddUndirectedEdge(5, 6)
+	// 环7-8-9没有割点
+	graph.AddEdge(7, 8)
+	graph.AddEdge(8, 9)
+	graph.AddEdge(9, 7)
+	graph.AddEdge(9, 9)
+	graph.AddNode(10)
+
+	assert.Equal(t, []int{2, 5}, graph.ArticulationPoints(), "应返回各连通分量中的割点")
+	assert.Empty(t, ggraph.NewGraph[int]().ArticulationPoints(), "空图没有割点")
+}
+
+func TestBridges(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	// 两个稠密簇{0,1,2,3}和{4,5,6,7}
+	for _, base := range []int{0, 4} {
+		for i := base; i < base+4; i++ {
+			for j := i + 1; j < base+4; j++ {
+				graph.AddUndirectedEdge(i, j)
+			}
+		}
+	}
+	graph.AddEdge(5, 2) // 两簇之间唯一的连接
+
+	assert.Equal(t, []ggraph.Edge[int]{{From: 5, To: 2}}, graph.Bridges(), "应只返回一条桥且方向与图中一致")
+}
+
+func TestBridgesChain(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddUndirectedEdge("A", "B")
+	graph.AddEdge("B", "C")
+	graph.AddEdge("C", "D")
+	graph.AddEdge("C", "D") // 平行边视为同一条无向边
+
+	assert.ElementsMatch(t, []ggraph.Edge[string]{{From: "A", To: "B"}, {From: "B", To: "C"}, {From: "C", To: "D"}},
+		graph.Bridges(), "链上的每条边都是桥且只返回一次")
+}
@@ -0,0 +1,133 @@
+package ggraph
+
+import "slices"
+
+// FindCycles 使用Johnson算法返回图中所有的基本有向环（环上节点不重复）
+// 每个环以其中索引最小的节点开头，按边的方向排列，不重复起点；自环作为单节点环返回
+// 环按起点索引顺序发现；平行边不会产生重复的环；环的数量可能随图规模指数增长，必要时请使用FindCyclesBounded
+func (g *Graph[T]) FindCycles() [][]T {
+	return g.FindCyclesBounded(0)
+}
+
+// FindCyclesBounded 同FindCycles，但至多返回maxCount个环，maxCount小于等于0表示不限制
+func (g *Graph[T]) FindCyclesBounded(maxCount int) [][]T {
+	cycles := make([][]T, 0)
+	n := len(g.nodes)
+	// 去除平行边，保留自环
+	adj := make([][]int, n)
+	for from, neighbors := range g.adj {
+		for _, to := range neighbors {
+			if !slices.Contains(adj[from], to) {
+				adj[from] = append(adj[from], to)
+			}
+		}
+	}
+	reverse := make([][]int, n)
+	for from, neighbors := range adj {
+		for _, to := range neighbors {
+			reverse[to] = append(reverse[to], from)
+		}
+	}
+
+	// frame 回路搜索的DFS栈帧，found表示经该节点已找到环
+	type frame struct {
+		index, next int
+		found       bool
+	}
+	blocked := make([]bool, n)
+	blockedBy := make([][]int, n)
+	// unblock 解除u的阻塞，并递归解除因u而阻塞的节点
+	unblock := func(u int) {
+		pending := []int{u}
+		blocked[u] = false
+		for len(pending) > 0 {
+			current := pending[len(pending)-1]
+			pending = pending[:len(pending)-1]
+			for _, w := range blockedBy[current] {
+				if blocked[w] {
+					blocked[w] = false
+					pending = append(pending, w)
+				}
+			}
+			blockedBy[current] = blockedBy[current][:0]
+		}
+	}
+	for start := 0; start < n; start++ {
+		// 在索引不小于start的节点构成的子图中，只有与start强连通的节点可能出现在以start开头的环上
+		inComponent := intersect(reachableWithin(adj, start), reachableWithin(reverse, start))
+		for i := start; i < n; i++ {
+			blocked[i] = false
+			blockedBy[i] = blockedBy[i][:0]
+		}
+
+		path := []int{start}
+		blocked[start] = true
+		stack := []frame{{index: start}}
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			v := top.index
+			if top.next < len(adj[v]) {
+				w := adj[v][top.next]
+				top.next++
+				if w < start || !inComponent[w] {
+					continue
+				}
+				if w == start {
+					cycles = append(cycles, g.toNodes(path))
+					if maxCount > 0 && len(cycles) >= maxCount {
+						return cycles
+					}
+					top.found = true
+				} else if !blocked[w] {
+					path = append(path, w)
+					blocked[w] = true
+					stack = append(stack, frame{index: w})
+				}
+				continue
+			}
+			// v的所有邻居处理完毕
+			found := top.found
+			if found {
+				unblock(v)
+			} else {
+				for _, w := range adj[v] {
+					if w >= start && inComponent[w] && !slices.Contains(blockedBy[w], v) {
+						blockedBy[w] = append(blockedBy[w], v)
+					}
+				}
+			}
+			stack = stack[:len(stack)-1]
+			path = path[:len(path)-1]
+			if found && len(stack) > 0 {
+				stack[len(stack)-1].found = true
+			}
+		}
+	}
+	return cycles
+}
+
+// reachableWithin 在adj上返回从start出发、只经过索引不小于start的节点可到达的节点标记（含start）
+func reachableWithin(adj [][]int, start int) []bool {
+	reached := make([]bool, len(adj))
+	reached[start] = true
+	queue := []int{start}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, next := range adj[current] {
+			if next >= start && !reached[next] {
+				reached[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	return reached
+}
+
+// intersect 返回两个等长标记切片逐项取与的结果，结果写入a
+func intersect(a, b []bool) []bool {
+	for i := range a {
+		a[i] = a[i] && b[i]
+	}
+	return a
+}
@@ -0,0 +1,56 @@
+package ggraph_test
+
+import (
+	"testing"
+
+	"github.com/nosusume/ggraph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindCycles(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	// 环A->B->C->A
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", "C")
+	graph.AddEdge("C", "A")
+	// 环D->E->D，经C->D与前一个环相连
+	graph.AddEdge("C", "D")
+	graph.AddEdge("D", "E")
+	graph.AddEdge("E", "D")
+	graph.AddEdge("A", "B") // 平行边不应产生重复的环
+
+	cycles := graph.FindCycles()
+	assert.Equal(t, [][]string{{"A", "B", "C"}, {"D", "E"}}, cycles, "应找到两个不同的环")
+	assert.Empty(t, ggraph.NewGraph[int]().FindCycles(), "空图没有环")
+}
+
+func TestFindCyclesOverlapping(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	// 完全有向图K3共有5个基本环：3个二元环和2个三元环
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			if i != j {
+				graph.AddEdge(i, j)
+			}
+		}
+	}
+	cycles := graph.FindCycles()
+	assert.ElementsMatch(t, [][]int{{0, 1}, {0, 2}, {0, 1, 2}, {0, 2, 1}, {1, 2}}, cycles, "应找到全部基本环")
+
+	graph.AddEdge(1, 1)
+	assert.Contains(t, graph.FindCycles(), []int{1}, "自环应作为单节点环返回")
+	assert.Len(t, graph.FindCyclesBounded(2), 2, "应至多返回maxCount个环")
+
+	complete := ggraph.CompleteGraph(5)
+	// K5的基本环数量为C(5,2)·1!+C(5,3)·2!+C(5,4)·3!+C(5,5)·4! = 84
+	assert.Len(t, complete.FindCycles(), 84, "完全有向图K5应有84个基本环")
+}
+
+func TestFindCyclesDAG(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	graph.AddEdge(1, 2)
+	graph.AddEdge(2, 3)
+	graph.AddEdge(1, 3)
+
+	assert.Empty(t, graph.FindCycles(), "DAG中没有环")
+}
@@ -0,0 +1,215 @@
+package ggraph
+
+import (
+	"container/heap"
+	"slices"
+)
+
+// TopologicalSort 使用Kahn算法返回有向图的一个拓扑序
+// 图中存在环（包括自环）时返回ErrCyclicGraph；空图返回空切片且无错误
+// 入度为0的节点按索引顺序入队，结果是确定的
+func (g *Graph[T]) TopologicalSort() ([]T, error) {
+	order, ok := g.topoOrder()
+	if !ok {
+		return nil, ErrCyclicGraph
+	}
+	nodes := make([]T, len(order))
+	for i, idx := range order {
+		nodes[i] = g.indexToNode[idx]
+	}
+	return nodes, nil
+}
+
+// TopologicalSortFunc 使用Kahn算法返回有向图的拓扑序，每一步从所有就绪（入度已降为0）的节点中
+// 选取less意义下最小的一个，因此只要less是严格全序，结果就与节点和边的插入顺序无关
+// less认为相等的节点按索引顺序选取；图中存在环时返回ErrCyclicGraph
+func (g *Graph[T]) TopologicalSortFunc(less func(a, b T) bool) ([]T, error) {
+	inDegree := g.inDegrees()
+	ready := &readyQueue{less: func(a, b int) bool {
+		if less(g.indexToNode[a], g.indexToNode[b]) {
+			return true
+		}
+		if less(g.indexToNode[b], g.indexToNode[a]) {
+			return false
+		}
+		return a < b
+	}}
+	for idx, degree := range inDegree {
+		if degree == 0 {
+			heap.Push(ready, idx)
+		}
+	}
+	order := make([]T, 0, len(g.nodes))
+	for ready.Len() > 0 {
+		current := heap.Pop(ready).(int)
+		order = append(order, g.indexToNode[current])
+		for _, next := range g.adj[current] {
+			inDegree[next]--
+			if inDegree[next] == 0 {
+				heap.Push(ready, next)
+			}
+		}
+	}
+	if len(order) != len(g.nodes) {
+		return nil, ErrCyclicGraph
+	}
+	return order, nil
+}
+
+// readyQueue 基于container/heap的就绪节点索引最小堆，顺序由less决定
+type readyQueue struct {
+	items []int
+	less  func(a, b int) bool
+}
+
+func (q *readyQueue) Len() int           { return len(q.items) }
+func (q *readyQueue) Less(i, j int) bool { return q.less(q.items[i], q.items[j]) }
+func (q *readyQueue) Swap(i, j int)      { q.items[i], q.items[j] = q.items[j], q.items[i] }
+func (q *readyQueue) Push(x any)         { q.items = append(q.items, x.(int)) }
+func (q *readyQueue) Pop() any {
+	item := q.items[len(q.items)-1]
+	q.items = q.items[:len(q.items)-1]
+	return item
+}
+
+// topoOrder 使用Kahn算法返回节点索引的拓扑序，图中有环时第二个返回值为false
+func (g *Graph[T]) topoOrder() ([]int, bool) {
+	inDegree := g.inDegrees()
+	order := make([]int, 0, len(g.nodes))
+	for idx, degree := range inDegree {
+		if degree == 0 {
+			order = append(order, idx)
+		}
+	}
+	// order同时作为队列使用，head之前的元素已出队
+	for head := 0; head < len(order); head++ {
+		for _, next := range g.adj[order[head]] {
+			inDegree[next]--
+			if inDegree[next] == 0 {
+				order = append(order, next)
+			}
+		}
+	}
+	return order, len(order) == len(g.nodes)
+}
+
+// TopologicalLayers 使用Kahn算法按层返回拓扑序：第0层为所有入度为0的节点，
+// 其余每个节点位于其所有前驱所在层之后的第一层，同层节点之间没有依赖，可以并行处理
+// 层内节点按索引顺序排列；图中有环（包括自环）时返回ErrCyclicGraph，空图返回空切片
+func (g *Graph[T]) TopologicalLayers() ([][]T, error) {
+	inDegree := g.inDegrees()
+	var current []int
+	for idx, degree := range inDegree {
+		if degree == 0 {
+			current = append(current, idx)
+		}
+	}
+	layers := make([][]T, 0)
+	visited := 0
+	for len(current) > 0 {
+		layers = append(layers, g.toNodes(current))
+		visited += len(current)
+		var next []int
+		for _, idx := range current {
+			for _, to := range g.adj[idx] {
+				inDegree[to]--
+				if inDegree[to] == 0 {
+					next = append(next, to)
+				}
+			}
+		}
+		slices.Sort(next)
+		current = next
+	}
+	if visited != len(g.nodes) {
+		return nil, ErrCyclicGraph
+	}
+	return layers, nil
+}
+
+// LongestPath 在DAG上按拓扑序动态规划求最长路径，返回路径节点及其长度
+// 长度按跳数（边数）计算，边权重不参与计算；存在多条最长路径时返回拓扑序中终点最靠前的一条
+// 图中有环时返回ErrCyclicGraph；空图返回空路径，无边的图返回仅含首个节点、长度为0的路径
+func (g *Graph[T]) LongestPath() ([]T, int, error) {
+	order, ok := g.topoOrder()
+	if !ok {
+		return nil, 0, ErrCyclicGraph
+	}
+	if len(order) == 0 {
+		return []T{}, 0, nil
+	}
+	dist := make([]int, len(g.nodes))
+	prev := make([]int, len(g.nodes))
+	for i := range prev {
+		prev[i] = i
+	}
+	end := order[0]
+	for _, current := range order {
+		for _, next := range g.adj[current] {
+			if dist[current]+1 > dist[next] {
+				dist[next] = dist[current] + 1
+				prev[next] = current
+			}
+		}
+		if dist[current] > dist[end] {
+			end = current
+		}
+	}
+	return g.buildPath(prev, end), dist[end], nil
+}
+
+// 节点在DFS中的着色状态
+const (
+	white = iota // 未访问
+	gray         // 在当前递归栈中
+	black        // 已完成
+)
+
+// HasCycle 使用DFS三色标记检测有向图中是否存在环
+// 自环视为环；会遍历所有连通分量
+func (g *Graph[T]) HasCycle() bool {
+	color := make([]int, len(g.nodes))
+	for start := range color {
+		if color[start] != white {
+			continue
+		}
+		color[start] = gray
+		stack := []dfsFrame{{index: start}}
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			neighbors := g.adj[top.index]
+			if top.next == len(neighbors) {
+				color[top.index] = black
+				stack = stack[:len(stack)-1]
+				continue
+			}
+			next := neighbors[top.next]
+			top.next++
+			switch color[next] {
+			case gray:
+				// 回边，指向栈中节点
+				return true
+			case white:
+				color[next] = gray
+				stack = append(stack, dfsFrame{index: next})
+			}
+		}
+	}
+	return false
+}
+
+// IsDAG 返回有向图是否无环（自环也视为环），发现第一个环时即返回
+func (g *Graph[T]) IsDAG() bool {
+	return !g.HasCycle()
+}
+
+// inDegrees 返回每个节点索引的入度（平行边按重数计）
+func (g *Graph[T]) inDegrees() []int {
+	inDegree := make([]int, len(g.nodes))
+	for _, neighbors := range g.adj {
+		for _, to := range neighbors {
+			inDegree[to]++
+		}
+	}
+	return inDegree
+}
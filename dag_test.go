@@ -0,0 +1,193 @@
+package ggraph_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/nosusume/ggraph"
+	"github.com/stretchr/testify/assert"
+)
+
+// assertTopological 断言order是graph的合法拓扑序
+func assertTopological[T comparable](t *testing.T, graph *ggraph.Graph[T], order []T) {
+	t.Helper()
+	assert.ElementsMatch(t, graph.Nodes(), order, "拓扑序应包含全部节点")
+	for _, edge := range graph.Edges() {
+		assert.Less(t, slices.Index(order, edge.From), slices.Index(order, edge.To),
+			"边%v->%v的起点应排在终点之前", edge.From, edge.To)
+	}
+}
+
+func TestTopologicalSort(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("shirt", "tie")
+	graph.AddEdge("tie", "jacket")
+	graph.AddEdge("pants", "shoes")
+	graph.AddEdge("pants", "belt")
+	graph.AddEdge("belt", "jacket")
+	graph.AddEdge("shirt", "belt")
+	graph.AddNode("watch")
+
+	order, err := graph.TopologicalSort()
+	assert.NoError(t, err, "DAG不应返回错误")
+	assertTopological(t, graph, order)
+}
+
+func TestTopologicalSortCycle(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	graph.AddEdge(1, 2)
+	graph.AddEdge(2, 3)
+	graph.AddEdge(3, 1)
+
+	order, err := graph.TopologicalSort()
+	assert.ErrorIs(t, err, ggraph.ErrCyclicGraph, "有环图应返回ErrCyclicGraph")
+	assert.Nil(t, order, "有环图不应返回拓扑序")
+}
+
+func TestTopologicalSortSelfLoop(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	graph.AddEdge(1, 2)
+	graph.AddEdge(2, 2)
+
+	_, err := graph.TopologicalSort()
+	assert.ErrorIs(t, err, ggraph.ErrCyclicGraph, "自环应视为环")
+}
+
+func TestTopologicalSortEmpty(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+
+	order, err := graph.TopologicalSort()
+	assert.NoError(t, err, "空图不应返回错误")
+	assert.NotNil(t, order, "空图应返回空切片而非nil")
+	assert.Empty(t, order, "空图应返回空切片")
+}
+
+func TestTopologicalSortFunc(t *testing.T) {
+	edges := []ggraph.Edge[string]{
+		{From: "b", To: "d"},
+		{From: "a", To: "d"},
+		{From: "c", To: "e"},
+		{From: "d", To: "e"},
+		{From: "a", To: "c"},
+	}
+	forward, backward := ggraph.NewGraph[string](), ggraph.NewGraph[string]()
+	for i := range edges {
+		forward.AddEdge(edges[i].From, edges[i].To)
+		last := edges[len(edges)-1-i]
+		backward.AddEdge(last.From, last.To)
+	}
+	less := func(a, b string) bool { return a < b }
+
+	first, err := forward.TopologicalSortFunc(less)
+	assert.NoError(t, err, "DAG不应返回错误")
+	second, err := backward.TopologicalSortFunc(less)
+	assert.NoError(t, err, "DAG不应返回错误")
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, first, "就绪节点应按比较函数选取")
+	assert.Equal(t, first, second, "仅边插入顺序不同的图应得到相同的拓扑序")
+	assertTopological(t, forward, first)
+
+	reversed, _ := forward.TopologicalSortFunc(func(a, b string) bool { return a > b })
+	assert.Equal(t, []string{"b", "a", "d", "c", "e"}, reversed, "不同的比较函数应得到相应的拓扑序")
+
+	forward.AddEdge("e", "a")
+	_, err = forward.TopologicalSortFunc(less)
+	assert.ErrorIs(t, err, ggraph.ErrCyclicGraph, "有环图应返回ErrCyclicGraph")
+}
+
+func TestHasCycle(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", "C")
+	assert.False(t, graph.HasCycle(), "链状图不应有环")
+
+	graph.AddEdge("C", "A")
+	assert.True(t, graph.HasCycle(), "三节点环应被检测到")
+}
+
+func TestHasCycleSelfLoop(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	graph.AddEdge(1, 2)
+	graph.AddNode(3)
+	graph.AddEdge(3, 3)
+	assert.True(t, graph.HasCycle(), "其他连通分量中的自环应被检测到")
+}
+
+func TestHasCycleLargeDAG(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	for i := 0; i < 2000; i++ {
+		graph.AddEdge(i, i+1)
+		graph.AddEdge(i, i+2)
+	}
+	assert.False(t, graph.HasCycle(), "大型DAG不应有环")
+}
+
+func TestIsDAG(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	for i := 0; i < 10; i++ {
+		graph.AddEdge(i, i+1)
+	}
+	assert.True(t, graph.IsDAG(), "线性链应为DAG")
+
+	graph.AddEdge(8, 3) // 回边
+	assert.False(t, graph.IsDAG(), "存在回边时不应为DAG")
+	assert.True(t, ggraph.NewGraph[int]().IsDAG(), "空图应为DAG")
+}
+
+func TestTopologicalLayers(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "C")
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", "D")
+	graph.AddEdge("C", "D")
+
+	layers, err := graph.TopologicalLayers()
+	assert.NoError(t, err, "DAG不应返回错误")
+	assert.Equal(t, [][]string{{"A"}, {"C", "B"}, {"D"}}, layers, "菱形DAG应分为3层，层内按索引顺序")
+
+	graph.AddEdge("A", "D") // 捷径边不应把D提前
+	graph.AddNode("E")
+	layers, err = graph.TopologicalLayers()
+	assert.NoError(t, err, "DAG不应返回错误")
+	assert.Equal(t, [][]string{{"A", "E"}, {"C", "B"}, {"D"}}, layers, "节点应位于其所有前驱之后")
+
+	graph.AddEdge("D", "A")
+	_, err = graph.TopologicalLayers()
+	assert.ErrorIs(t, err, ggraph.ErrCyclicGraph, "有环图应返回ErrCyclicGraph")
+	empty, err := ggraph.NewGraph[int]().TopologicalLayers()
+	assert.NoError(t, err, "空图不应返回错误")
+	assert.Empty(t, empty, "空图应返回空切片")
+}
+
+func TestLongestPath(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("start", "design")
+	graph.AddEdge("start", "buy")
+	graph.AddEdge("design", "build")
+	graph.AddEdge("build", "test")
+	graph.AddEdge("buy", "test")
+	graph.AddEdge("test", "ship")
+	graph.AddEdge("start", "ship")
+
+	path, length, err := graph.LongestPath()
+	assert.NoError(t, err, "DAG不应返回错误")
+	assert.Equal(t, []string{"start", "design", "build", "test", "ship"}, path, "应返回关键路径")
+	assert.Equal(t, 4, length, "关键路径长度应为4")
+}
+
+func TestLongestPathEdgeCases(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	path, length, err := graph.LongestPath()
+	assert.NoError(t, err, "空图不应返回错误")
+	assert.Empty(t, path, "空图应返回空路径")
+	assert.Zero(t, length, "空图最长路径长度应为0")
+
+	graph.AddNode(1)
+	path, length, _ = graph.LongestPath()
+	assert.Equal(t, []int{1}, path, "无边的图应返回单节点路径")
+	assert.Zero(t, length, "无边的图最长路径长度应为0")
+
+	graph.AddEdge(1, 2)
+	graph.AddEdge(2, 1)
+	_, _, err = graph.LongestPath()
+	assert.ErrorIs(t, err, ggraph.ErrCyclicGraph, "有环图应返回ErrCyclicGraph")
+}
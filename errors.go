@@ -0,0 +1,22 @@
+package ggraph
+
+import "errors"
+
+var (
+	// ErrCyclicGraph 图中存在有向环，无法执行仅适用于DAG的操作
+	ErrCyclicGraph = errors.New("ggraph: graph contains a cycle")
+	// ErrDisconnected 图不连通，无法执行要求连通图的操作
+	ErrDisconnected = errors.New("ggraph: graph is disconnected")
+	// ErrNodeNotFound 指定的节点不存在
+	ErrNodeNotFound = errors.New("ggraph: node not found")
+	// ErrEdgeNotFound 指定的边不存在
+	ErrEdgeNotFound = errors.New("ggraph: edge not found")
+	// ErrNegativeCycle 图中存在总权重为负的环，最短路径无定义
+	ErrNegativeCycle = errors.New("ggraph: graph contains a negative cycle")
+	// ErrNegativeWeight 边权重为负，无法执行要求非负权重的操作
+	ErrNegativeWeight = errors.New("ggraph: negative edge weight")
+	// ErrNotBipartite 图不满足二分图的划分要求
+	ErrNotBipartite = errors.New("ggraph: graph is not bipartite")
+	// ErrFrozenGraph 图已被冻结，不允许修改；修改冻结图的方法以该错误为参数panic
+	ErrFrozenGraph = errors.New("ggraph: graph is frozen")
+)
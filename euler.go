@@ -0,0 +1,54 @@
+package ggraph
+
+import "slices"
+
+// EulerianPath 判断有向图是否存在欧拉路径（恰好经过每条边一次），存在时使用Hierholzer算法返回一条
+// 存在条件：至多一个节点出度比入度大1（作为起点）、至多一个节点入度比出度大1（作为终点），
+// 其余节点出入度相等，且所有带边的节点弱连通；所有节点出入度相等时返回的是欧拉回路
+// 无边的图返回(nil, false)；无向图按其存储的双向有向边判断
+func (g *Graph[T]) EulerianPath() ([]T, bool) {
+	edgeCount := g.EdgeCount()
+	if edgeCount == 0 {
+		return nil, false
+	}
+	inDegree := g.inDegrees()
+	start, starts, ends := -1, 0, 0
+	for idx := range g.adj {
+		switch diff := len(g.adj[idx]) - inDegree[idx]; {
+		case diff == 1:
+			starts++
+			start = idx
+		case diff == -1:
+			ends++
+		case diff != 0:
+			return nil, false
+		}
+	}
+	if starts > 1 || ends > 1 || starts != ends {
+		return nil, false
+	}
+	if start == -1 {
+		start = slices.IndexFunc(g.adj, func(neighbors []int) bool { return len(neighbors) > 0 })
+	}
+
+	// Hierholzer算法：沿未使用的边前进，无路可走时将节点加入回路
+	next := make([]int, len(g.nodes))
+	stack := []int{start}
+	circuit := make([]int, 0, edgeCount+1)
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		if next[v] < len(g.adj[v]) {
+			stack = append(stack, g.adj[v][next[v]])
+			next[v]++
+			continue
+		}
+		circuit = append(circuit, v)
+		stack = stack[:len(stack)-1]
+	}
+	// 带边的节点不连通时无法用完所有边
+	if len(circuit) != edgeCount+1 {
+		return nil, false
+	}
+	slices.Reverse(circuit)
+	return g.toNodes(circuit), true
+}
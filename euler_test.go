@@ -0,0 +1,68 @@
+package ggraph_test
+
+import (
+	"testing"
+
+	"github.com/nosusume/ggraph"
+	"github.com/stretchr/testify/assert"
+)
+
+// assertEulerian 断言path恰好经过graph的每条边一次
+func assertEulerian[T comparable](t *testing.T, graph *ggraph.Graph[T], path []T) {
+	t.Helper()
+	used := make([]ggraph.Edge[T], 0, len(path))
+	for i := 0; i+1 < len(path); i++ {
+		used = append(used, ggraph.Edge[T]{From: path[i], To: path[i+1]})
+	}
+	assert.ElementsMatch(t, graph.Edges(), used, "路径应恰好经过每条边一次")
+}
+
+func TestEulerianCircuit(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	graph.AddEdge(0, 1)
+	graph.AddEdge(1, 2)
+	graph.AddEdge(2, 0)
+	graph.AddEdge(1, 3)
+	graph.AddEdge(3, 4)
+	graph.AddEdge(4, 1)
+
+	path, ok := graph.EulerianPath()
+	assert.True(t, ok, "出入度均相等的连通图应存在欧拉回路")
+	assert.Equal(t, path[0], path[len(path)-1], "欧拉回路应首尾相同")
+	assertEulerian(t, graph, path)
+}
+
+func TestEulerianPath(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", "C")
+	graph.AddEdge("C", "A")
+	graph.AddEdge("A", "D")
+
+	path, ok := graph.EulerianPath()
+	assert.True(t, ok, "应存在欧拉路径")
+	assert.Equal(t, "A", path[0], "应从出度大于入度的节点出发")
+	assert.Equal(t, "D", path[len(path)-1], "应在入度大于出度的节点结束")
+	assertEulerian(t, graph, path)
+}
+
+func TestEulerianPathNone(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	graph.AddEdge(0, 1)
+	graph.AddEdge(0, 2)
+	graph.AddEdge(0, 3)
+	path, ok := graph.EulerianPath()
+	assert.False(t, ok, "度数条件不满足时不应存在欧拉路径")
+	assert.Nil(t, path, "不存在时应返回nil")
+
+	disconnected := ggraph.NewGraph[int]()
+	disconnected.AddEdge(0, 1)
+	disconnected.AddEdge(1, 0)
+	disconnected.AddEdge(2, 3)
+	disconnected.AddEdge(3, 2)
+	_, ok = disconnected.EulerianPath()
+	assert.False(t, ok, "带边节点不连通时不应存在欧拉路径")
+
+	_, ok = ggraph.NewGraph[int]().EulerianPath()
+	assert.False(t, ok, "无边的图应返回false")
+}
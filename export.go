@@ -0,0 +1,130 @@
+package ggraph
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// dotEscaper 转义DOT双引号字符串中的反斜杠和双引号
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// ToDOT 将图导出为Graphviz的digraph格式
+// 先按索引顺序声明全部节点（包括孤立节点），再逐行输出每条边，输出是确定的
+// 节点标签取自fmt.Sprintf("%v", node)，并转义其中的双引号和反斜杠
+func (g *Graph[T]) ToDOT() string {
+	var builder strings.Builder
+	builder.WriteString("digraph {\n")
+	labels := make([]string, len(g.indexToNode))
+	for idx, node := range g.indexToNode {
+		labels[idx] = `"` + dotEscaper.Replace(fmt.Sprintf("%v", node)) + `"`
+		builder.WriteString(fmt.Sprintf("  %s;\n", labels[idx]))
+	}
+	for from, neighbors := range g.adj {
+		for _, to := range neighbors {
+			builder.WriteString(fmt.Sprintf("  %s -> %s;\n", labels[from], labels[to]))
+		}
+	}
+	builder.WriteString("}\n")
+	return builder.String()
+}
+
+// mermaidEscaper 将Mermaid标签中会破坏语法的字符替换为实体编码
+var mermaidEscaper = strings.NewReplacer(`"`, "#quot;")
+
+// ToMermaid 将图导出为Mermaid的graph TD代码块（不含Markdown围栏）
+// 节点id按索引顺序为n0、n1、...以避免特殊字符，标签取自fmt.Sprintf("%v", node)；
+// 先声明全部节点（包括孤立节点），再逐行输出每条边，输出是确定的
+func (g *Graph[T]) ToMermaid() string {
+	var builder strings.Builder
+	builder.WriteString("graph TD\n")
+	for idx, node := range g.indexToNode {
+		builder.WriteString(fmt.Sprintf("  n%d[\"%s\"]\n", idx, mermaidEscaper.Replace(fmt.Sprintf("%v", node))))
+	}
+	for from, neighbors := range g.adj {
+		for _, to := range neighbors {
+			builder.WriteString(fmt.Sprintf("  n%d --> n%d\n", from, to))
+		}
+	}
+	return builder.String()
+}
+
+// graphML GraphML文档根元素
+type graphML struct {
+	XMLName xml.Name     `xml:"graphml"`
+	XMLNS   string       `xml:"xmlns,attr"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+// graphMLKey GraphML属性声明
+type graphMLKey struct {
+	ID       string `xml:"id,attr"`
+	For      string `xml:"for,attr"`
+	AttrName string `xml:"attr.name,attr"`
+	AttrType string `xml:"attr.type,attr"`
+}
+
+// graphMLGraph GraphML图元素
+type graphMLGraph struct {
+	ID          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+// graphMLNode GraphML节点元素
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+// graphMLEdge GraphML边元素
+type graphMLEdge struct {
+	ID     string        `xml:"id,attr"`
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphMLData `xml:"data"`
+}
+
+// graphMLData GraphML属性值
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// ToGraphML 将图导出为GraphML格式的XML，可在yEd、Gephi等工具中打开
+// 节点id按索引顺序为n0、n1、...，标签取自fmt.Sprintf("%v", node)并存于label属性，
+// 边id为e0、e1、...，边权重存于weight属性；图默认方向为directed
+func (g *Graph[T]) ToGraphML() ([]byte, error) {
+	doc := graphML{
+		XMLNS: "http://graphml.graphdrawing.org/xmlns",
+		Keys: []graphMLKey{
+			{ID: "label", For: "node", AttrName: "label", AttrType: "string"},
+			{ID: "weight", For: "edge", AttrName: "weight", AttrType: "double"},
+		},
+		Graph: graphMLGraph{ID: "G", EdgeDefault: "directed"},
+	}
+	for idx, node := range g.indexToNode {
+		doc.Graph.Nodes = append(doc.Graph.Nodes, graphMLNode{
+			ID:   "n" + strconv.Itoa(idx),
+			Data: []graphMLData{{Key: "label", Value: fmt.Sprintf("%v", node)}},
+		})
+	}
+	for from, neighbors := range g.adj {
+		for i, to := range neighbors {
+			doc.Graph.Edges = append(doc.Graph.Edges, graphMLEdge{
+				ID:     "e" + strconv.Itoa(len(doc.Graph.Edges)),
+				Source: "n" + strconv.Itoa(from),
+				Target: "n" + strconv.Itoa(to),
+				Data:   []graphMLData{{Key: "weight", Value: strconv.FormatFloat(g.weights[from][i], 'g', -1, 64)}},
+			})
+		}
+	}
+	data, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+}
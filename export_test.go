@@ -0,0 +1,99 @@
+package ggraph_test
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/nosusume/ggraph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToDOT(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", `say "hi"`)
+	graph.AddEdge(`C:\dir`, "A")
+	graph.AddNode("D")
+
+	expected := `digraph {
+  "A";
+  "B";
+  "say \"hi\"";
+  "C:\\dir";
+  "D";
+  "A" -> "B";
+  "B" -> "say \"hi\"";
+  "C:\\dir" -> "A";
+}
+`
+	dot := graph.ToDOT()
+	assert.Equal(t, expected, dot, "DOT输出应按索引顺序且正确转义")
+	assert.True(t, strings.HasPrefix(dot, "digraph {"), "应输出digraph")
+	assert.Equal(t, graph.EdgeCount(), strings.Count(dot, " -> "), "每条边应输出一行")
+}
+
+func TestToMermaid(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", `say "hi"`)
+	graph.AddEdge("C D", "A")
+	graph.AddNode("E")
+
+	expected := `graph TD
+  n0["A"]
+  n1["B"]
+  n2["say #quot;hi#quot;"]
+  n3["C D"]
+  n4["E"]
+  n0 --> n1
+  n1 --> n2
+  n3 --> n0
+`
+	mermaid := graph.ToMermaid()
+	assert.Equal(t, expected, mermaid, "Mermaid输出应按索引顺序且正确转义")
+	assert.True(t, strings.HasPrefix(mermaid, "graph TD\n"), "应以Mermaid头部开始")
+	assert.Equal(t, graph.EdgeCount(), strings.Count(mermaid, " --> "), "每条边应输出一行")
+}
+
+func TestToGraphML(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "B")
+	graph.AddWeightedEdge("B", "C", 2.5)
+	graph.AddNode("<D&>")
+
+	data, err := graph.ToGraphML()
+	assert.NoError(t, err, "导出GraphML不应出错")
+	assert.True(t, strings.HasPrefix(string(data), "<?xml"), "应包含XML声明")
+
+	var doc struct {
+		Graph struct {
+			EdgeDefault string `xml:"edgedefault,attr"`
+			Nodes       []struct {
+				ID    string `xml:"id,attr"`
+				Label string `xml:"data"`
+			} `xml:"node"`
+			Edges []struct {
+				Source string `xml:"source,attr"`
+				Target string `xml:"target,attr"`
+				Weight string `xml:"data"`
+			} `xml:"edge"`
+		} `xml:"graph"`
+	}
+	assert.NoError(t, xml.Unmarshal(data, &doc), "GraphML应为格式良好的XML")
+	assert.Equal(t, "directed", doc.Graph.EdgeDefault, "默认方向应为directed")
+
+	ids := make(map[string]string)
+	for i, node := range doc.Graph.Nodes {
+		assert.Equal(t, fmt.Sprintf("n%d", i), node.ID, "节点id应按索引顺序编号")
+		ids[node.ID] = node.Label
+	}
+	assert.Equal(t, "<D&>", ids["n3"], "标签应正确转义并还原")
+	assert.Len(t, doc.Graph.Edges, 2, "应导出全部边")
+	for _, edge := range doc.Graph.Edges {
+		assert.Contains(t, ids, edge.Source, "边的source应为已声明节点")
+		assert.Contains(t, ids, edge.Target, "边的target应为已声明节点")
+	}
+	assert.Equal(t, "2.5", doc.Graph.Edges[1].Weight, "边权重应导出")
+}
@@ -0,0 +1,81 @@
+package ggraph
+
+import (
+	"fmt"
+	"math"
+)
+
+// flowEdge 残量网络中的边，rev为反向边在to的边列表中的位置
+type flowEdge struct {
+	to       int
+	capacity float64
+	rev      int
+}
+
+// MaxFlow 使用Edmonds-Karp算法（BFS寻找最短增广路径）计算从source到sink的最大流
+// 边权重视为容量，平行边的容量相加，自环被忽略；无向图模式下每条无向边在两个方向上各有一份容量
+// source与sink之间不存在路径或二者相同时最大流为0；节点不存在时返回ErrNodeNotFound，
+// 存在负容量的边时返回ErrNegativeWeight
+func (g *Graph[T]) MaxFlow(source, sink T) (float64, error) {
+	sourceIndex, exists := g.nodes[source]
+	if !exists {
+		return 0, fmt.Errorf("%w: %v", ErrNodeNotFound, source)
+	}
+	sinkIndex, exists := g.nodes[sink]
+	if !exists {
+		return 0, fmt.Errorf("%w: %v", ErrNodeNotFound, sink)
+	}
+	residual := make([][]flowEdge, len(g.nodes))
+	for from, neighbors := range g.adj {
+		for i, to := range neighbors {
+			capacity := g.weights[from][i]
+			if capacity < 0 {
+				return 0, fmt.Errorf("%w: %v->%v", ErrNegativeWeight, g.indexToNode[from], g.indexToNode[to])
+			}
+			if from == to {
+				continue
+			}
+			residual[from] = append(residual[from], flowEdge{to: to, capacity: capacity, rev: len(residual[to])})
+			residual[to] = append(residual[to], flowEdge{to: from, rev: len(residual[from]) - 1})
+		}
+	}
+	if sourceIndex == sinkIndex {
+		return 0, nil
+	}
+
+	total := 0.0
+	// prevEdge[v]记录增广路径上到达v所用边在前驱边列表中的位置
+	prev := make([]int, len(g.nodes))
+	prevEdge := make([]int, len(g.nodes))
+	for {
+		for i := range prev {
+			prev[i] = -1
+		}
+		prev[sourceIndex] = sourceIndex
+		queue := []int{sourceIndex}
+		for len(queue) > 0 && prev[sinkIndex] == -1 {
+			current := queue[0]
+			queue = queue[1:]
+			for i, edge := range residual[current] {
+				if edge.capacity > 0 && prev[edge.to] == -1 {
+					prev[edge.to] = current
+					prevEdge[edge.to] = i
+					queue = append(queue, edge.to)
+				}
+			}
+		}
+		if prev[sinkIndex] == -1 {
+			return total, nil
+		}
+		bottleneck := math.Inf(1)
+		for v := sinkIndex; v != sourceIndex; v = prev[v] {
+			bottleneck = min(bottleneck, residual[prev[v]][prevEdge[v]].capacity)
+		}
+		for v := sinkIndex; v != sourceIndex; v = prev[v] {
+			edge := &residual[prev[v]][prevEdge[v]]
+			edge.capacity -= bottleneck
+			residual[v][edge.rev].capacity += bottleneck
+		}
+		total += bottleneck
+	}
+}
@@ -0,0 +1,51 @@
+package ggraph_test
+
+import (
+	"testing"
+
+	"github.com/nosusume/ggraph"
+	"github.com/stretchr/testify/assert"
+)
+
+// newFlowTestGraph 构建《算法导论》中经典的流网络，最大流为23
+func newFlowTestGraph() *ggraph.Graph[string] {
+	graph := ggraph.NewGraph[string]()
+	graph.AddWeightedEdge("s", "v1", 16)
+	graph.AddWeightedEdge("s", "v2", 13)
+	graph.AddWeightedEdge("v1", "v3", 12)
+	graph.AddWeightedEdge("v2", "v1", 4)
+	graph.AddWeightedEdge("v2", "v4", 14)
+	graph.AddWeightedEdge("v3", "v2", 9)
+	graph.AddWeightedEdge("v3", "t", 20)
+	graph.AddWeightedEdge("v4", "v3", 7)
+	graph.AddWeightedEdge("v4", "t", 4)
+	return graph
+}
+
+func TestMaxFlow(t *testing.T) {
+	graph := newFlowTestGraph()
+
+	flow, err := graph.MaxFlow("s", "t")
+	assert.NoError(t, err, "不应返回错误")
+	assert.Equal(t, 23.0, flow, "经典流网络的最大流应为23")
+
+	flow, err = graph.MaxFlow("t", "s")
+	assert.NoError(t, err, "不存在路径时不应返回错误")
+	assert.Zero(t, flow, "不存在路径时最大流应为0")
+
+	graph.AddWeightedEdge("s", "t", 5)
+	graph.AddWeightedEdge("s", "t", 2)
+	flow, _ = graph.MaxFlow("s", "t")
+	assert.Equal(t, 30.0, flow, "平行边的容量应相加")
+}
+
+func TestMaxFlowErrors(t *testing.T) {
+	graph := newFlowTestGraph()
+
+	_, err := graph.MaxFlow("s", "x")
+	assert.ErrorIs(t, err, ggraph.ErrNodeNotFound, "节点不存在时应返回ErrNodeNotFound")
+
+	graph.AddWeightedEdge("v1", "v4", -1)
+	_, err = graph.MaxFlow("s", "t")
+	assert.ErrorIs(t, err, ggraph.ErrNegativeWeight, "存在负容量时应返回ErrNegativeWeight")
+}
@@ -0,0 +1,21 @@
+package ggraph
+
+// Freeze 冻结图并返回g本身，便于在构建完成后链式调用
+// 冻结后读取方法照常工作，而添加、删除节点或边、设置属性、反序列化等所有修改操作都会以ErrFrozenGraph为参数panic；
+// 冻结不可撤销，如需修改请使用Clone得到可修改的副本
+func (g *Graph[T]) Freeze() *Graph[T] {
+	g.frozen = true
+	return g
+}
+
+// IsFrozen 返回图是否已被冻结
+func (g *Graph[T]) IsFrozen() bool {
+	return g.frozen
+}
+
+// mustBeMutable 在图已冻结时以ErrFrozenGraph为参数panic，供所有修改操作在入口处调用
+func (g *Graph[T]) mustBeMutable() {
+	if g.frozen {
+		panic(ErrFrozenGraph)
+	}
+}
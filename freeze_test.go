@@ -0,0 +1,43 @@
+package ggraph_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/nosusume/ggraph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFreeze(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", "C")
+
+	frozen := graph.Freeze()
+	assert.Same(t, graph, frozen, "Freeze应返回图本身")
+	assert.True(t, frozen.IsFrozen(), "冻结后IsFrozen应返回true")
+	assert.True(t, frozen.HasEdge("A", "B"), "冻结后读取方法应照常工作")
+	assert.Equal(t, []string{"B"}, frozen.Neighbors("A"), "冻结后读取方法应照常工作")
+
+	mutations := map[string]func(){
+		"AddNode":        func() { frozen.AddNode("D") },
+		"AddEdge":        func() { frozen.AddEdge("A", "C") },
+		"RemoveNode":     func() { frozen.RemoveNode("A") },
+		"RemoveEdge":     func() { frozen.RemoveEdge("A", "B") },
+		"SetNodeAttr":    func() { frozen.SetNodeAttr("A", "color", "red") },
+		"Clear":          func() { frozen.Clear() },
+		"ReverseInPlace": func() { frozen.ReverseInPlace() },
+		"Merge":          func() { frozen.Merge(ggraph.NewGraph[string]()) },
+		"UnmarshalJSON":  func() { _ = json.Unmarshal([]byte(`{"nodes":[],"adj":[]}`), frozen) },
+	}
+	for name, mutate := range mutations {
+		assert.PanicsWithValue(t, ggraph.ErrFrozenGraph, mutate, "修改冻结图应panic: %s", name)
+	}
+	assert.Equal(t, 3, frozen.NodeCount(), "失败的修改不应改变图")
+	assert.Equal(t, 2, frozen.EdgeCount(), "失败的修改不应改变图")
+
+	clone := frozen.Clone()
+	assert.False(t, clone.IsFrozen(), "Clone得到的副本应可修改")
+	clone.AddEdge("C", "D")
+	assert.True(t, clone.HasEdge("C", "D"), "副本应可修改")
+}
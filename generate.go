@@ -0,0 +1,61 @@
+package ggraph
+
+import "math/rand/v2"
+
+// GenerateRandomGraph 生成节点为0..n-1的有向Erdős–Rényi随机图G(n,p)
+// 每个不同节点构成的有序对以概率p独立地连一条边，不生成自环，
+// 因此p=0时无边，p=1时为完全有向图（共n·(n-1)条边）；传入固定种子的rng可复现结果
+func GenerateRandomGraph(n int, p float64, rng *rand.Rand) *Graph[int] {
+	g := NewGraph[int]()
+	for i := 0; i < n; i++ {
+		g.AddNode(i)
+	}
+	for from := 0; from < n; from++ {
+		for to := 0; to < n; to++ {
+			if from != to && rng.Float64() < p {
+				g.AddEdge(from, to)
+			}
+		}
+	}
+	return g
+}
+
+// CompleteGraph 生成节点为0..n-1的完全有向图，每个不同节点构成的有序对之间都有一条边，不含自环
+// n为0时返回空图，n为1时返回单个孤立节点
+func CompleteGraph(n int) *Graph[int] {
+	g := NewGraph[int]()
+	for i := 0; i < n; i++ {
+		g.AddNode(i)
+	}
+	for from := 0; from < n; from++ {
+		for to := 0; to < n; to++ {
+			if from != to {
+				g.AddEdge(from, to)
+			}
+		}
+	}
+	return g
+}
+
+// GridGraph 生成rows×cols的无向网格图，节点为坐标[2]int{行, 列}
+// 采用4邻接：每个单元格与上下左右相邻的单元格相连，因此角落节点度为2、边缘节点度为3、内部节点度为4
+// 节点按行优先顺序添加；rows或cols小于等于0时返回空图
+func GridGraph(rows, cols int) *Graph[[2]int] {
+	g := NewUndirectedGraph[[2]int]()
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			g.AddNode([2]int{r, c})
+		}
+	}
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			if c+1 < cols {
+				g.AddEdge([2]int{r, c}, [2]int{r, c + 1})
+			}
+			if r+1 < rows {
+				g.AddEdge([2]int{r, c}, [2]int{r + 1, c})
+			}
+		}
+	}
+	return g
+}
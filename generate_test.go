@@ -0,0 +1,51 @@
+package ggraph_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/nosusume/ggraph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateRandomGraph(t *testing.T) {
+	empty := ggraph.GenerateRandomGraph(10, 0, rand.New(rand.NewPCG(1, 2)))
+	assert.Equal(t, 10, empty.NodeCount(), "应包含全部节点")
+	assert.Zero(t, empty.EdgeCount(), "p=0时不应有边")
+
+	complete := ggraph.GenerateRandomGraph(10, 1, rand.New(rand.NewPCG(1, 2)))
+	assert.Equal(t, 90, complete.EdgeCount(), "p=1时应为不含自环的完全有向图")
+	assert.Empty(t, complete.SelfLoops(), "不应生成自环")
+}
+
+func TestGenerateRandomGraphReproducible(t *testing.T) {
+	a := ggraph.GenerateRandomGraph(30, 0.2, rand.New(rand.NewPCG(42, 7)))
+	b := ggraph.GenerateRandomGraph(30, 0.2, rand.New(rand.NewPCG(42, 7)))
+	assert.Equal(t, a.Edges(), b.Edges(), "相同种子应生成相同的图")
+	assert.Greater(t, a.EdgeCount(), 0, "p=0.2时应生成部分边")
+	assert.Less(t, a.EdgeCount(), 30*29, "p=0.2时不应生成完全图")
+}
+
+func TestCompleteGraph(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 5} {
+		graph := ggraph.CompleteGraph(n)
+		assert.Equal(t, n, graph.NodeCount(), "n=%d时节点数量应为n", n)
+		assert.Equal(t, n*(n-1), graph.EdgeCount(), "n=%d时边数量应为n*(n-1)", n)
+		assert.Empty(t, graph.SelfLoops(), "完全图不应含自环")
+	}
+}
+
+func TestGridGraph(t *testing.T) {
+	graph := ggraph.GridGraph(3, 4)
+	assert.Equal(t, 12, graph.NodeCount(), "节点数量应为rows*cols")
+	assert.True(t, graph.IsUndirected(), "网格图应为无向图")
+	for _, corner := range [][2]int{{0, 0}, {0, 3}, {2, 0}, {2, 3}} {
+		assert.Equal(t, 2, graph.OutDegree(corner), "角落节点%v的度应为2", corner)
+	}
+	assert.Equal(t, 3, graph.OutDegree([2]int{0, 1}), "边缘节点的度应为3")
+	assert.Equal(t, 4, graph.OutDegree([2]int{1, 1}), "内部节点的度应为4")
+	assert.Equal(t, 4, graph.OutDegree([2]int{1, 2}), "内部节点的度应为4")
+	assert.True(t, graph.HasEdge([2]int{1, 2}, [2]int{0, 2}), "相邻单元格应相连")
+	assert.False(t, graph.HasEdge([2]int{0, 0}, [2]int{1, 1}), "对角单元格不应相连")
+	assert.Zero(t, ggraph.GridGraph(0, 5).NodeCount(), "rows为0时应返回空图")
+}
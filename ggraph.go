@@ -2,6 +2,8 @@ package ggraph
 
 import (
 	"fmt"
+	"iter"
+	"maps"
 	"slices"
 	"strings"
 )
@@ -10,17 +12,33 @@ import (
 type Graph[T comparable] struct {
 	// 节点映射，用于快速查找节点索引
 	nodes map[T]int
+	// 索引到节点的映射，下标即节点索引
+	indexToNode []T
 	// 邻接表，每个索引对应一个节点的邻居索引列表
 	adj [][]int
+	// 边权重表，与adj逐项对应，weights[i][j]为边adj[i][j]的权重
+	weights [][]float64
+	// 是否为无向图，无向图中每条边以两条方向相反的有向边存储
+	undirected bool
+	// 节点属性表，首次设置属性时初始化
+	nodeAttrs map[T]map[string]any
+	// 边属性表，按起止节点索引，同一节点对的平行边共享属性，首次设置属性时初始化
+	edgeAttrs map[Edge[T]]map[string]any
+	// 是否已冻结，冻结后所有修改操作都会panic
+	frozen bool
 }
 
 // GraphDTO 用于序列化图结构的DTO（Data Transfer Object）
-// 适用于JSON序列化，节点类型为interface{}以支持多种类型
+// 适用于JSON与YAML序列化，节点类型为interface{}以支持多种类型
 type GraphDTO struct {
 	// Nodes 存储图中的所有节点
-	Nodes []any `json:"nodes"`
+	Nodes []any `json:"nodes" yaml:"nodes"`
 	// Adjacency list，存储每个节点的邻居索引
-	Adj [][]int `json:"adj"`
+	Adj [][]int `json:"adj" yaml:"adj"`
+	// NodeAttrs 与Nodes逐项对应的节点属性，图中没有节点属性时省略
+	NodeAttrs []map[string]any `json:"node_attrs,omitempty" yaml:"node_attrs,omitempty"`
+	// Weights 与Adj逐项对应的边权重，所有边权重均为默认值1时省略
+	Weights [][]float64 `json:"weights,omitempty" yaml:"weights,omitempty"`
 }
 
 // Node 泛型节点接口，定义了从和到方法
@@ -64,25 +82,58 @@ func NewGraphByDTO(dto *GraphDTO) *Graph[any] {
 	}
 	// 添加所有边
 	for i, neighbors := range dto.Adj {
-		for _, neighborIndex := range neighbors {
+		for j, neighborIndex := range neighbors {
 			if neighborIndex < len(dto.Nodes) {
-				g.AddEdge(dto.Nodes[i], dto.Nodes[neighborIndex])
+				g.AddWeightedEdge(dto.Nodes[i], dto.Nodes[neighborIndex], dtoWeight(dto.Weights, i, j))
 			}
 		}
 	}
+	// 恢复节点属性
+	for i, attrs := range dto.NodeAttrs {
+		if i >= len(dto.Nodes) {
+			break
+		}
+		for key, value := range attrs {
+			g.SetNodeAttr(dto.Nodes[i], key, value)
+		}
+	}
 	return g
 }
 
+// dtoWeight 返回权重表中第i行第j条边的权重，权重表缺失或不完整时返回默认值1
+func dtoWeight(weights [][]float64, i, j int) float64 {
+	if i < len(weights) && j < len(weights[i]) {
+		return weights[i][j]
+	}
+	return 1
+}
+
 // NewGraph 初始化一个空的泛型邻接图
 func NewGraph[T comparable]() *Graph[T] {
 	return &Graph[T]{
-		nodes: make(map[T]int),
-		adj:   make([][]int, 0),
+		nodes:       make(map[T]int),
+		indexToNode: make([]T, 0),
+		adj:         make([][]int, 0),
+		weights:     make([][]float64, 0),
 	}
 }
 
+// NewUndirectedGraph 初始化一个空的无向图
+// 无向图中AddEdge、AddWeightedEdge同时添加两个方向的边，RemoveEdge同时删除两个方向的边
+func NewUndirectedGraph[T comparable]() *Graph[T] {
+	g := NewGraph[T]()
+	g.undirected = true
+	return g
+}
+
+// IsUndirected 返回图是否为无向图
+func (g *Graph[T]) IsUndirected() bool {
+	return g.undirected
+}
+
 // AddNode 向图中添加一个节点（去重）
 func (g *Graph[T]) AddNode(node T) {
+	g.mustBeMutable()
 	// 检查节点是否已存在
 	if _, exists := g.nodes[node]; exists {
 		return
@@ -90,51 +141,233 @@ func (g *Graph[T]) AddNode(node T) {
 	// 分配新索引
 	index := len(g.nodes)
 	g.nodes[node] = index
-	// 扩展邻接表（避免索引越界）
-	if index >= cap(g.adj) {
-		g.adj = append(g.adj, make([][]int, index+1)...)
+	g.indexToNode = append(g.indexToNode, node)
+	// 扩展邻接表，保证索引与邻接表一一对应
+	g.adj = append(g.adj, nil)
+	g.weights = append(g.weights, nil)
+}
+
+// AddNodes 批量添加节点，效果与依次调用AddNode相同，但会预先扩容内部切片
+func (g *Graph[T]) AddNodes(nodes ...T) {
+	g.mustBeMutable()
+	g.growNodes(len(nodes))
+	for _, node := range nodes {
+		g.AddNode(node)
 	}
 }
 
-// AddEdge 添加一条从from到to的有向边（自动添加缺失节点）
+// growNodes 为至多n个新节点预留索引表与邻接表的容量
+func (g *Graph[T]) growNodes(n int) {
+	g.indexToNode = slices.Grow(g.indexToNode, n)
+	g.adj = slices.Grow(g.adj, n)
+	g.weights = slices.Grow(g.weights, n)
+}
+
+// AddEdge 添加一条从from到to的有向边（自动添加缺失节点），权重默认为1
+// 无向图中等同于AddUndirectedEdge
 func (g *Graph[T]) AddEdge(from, to T) {
+	g.AddWeightedEdge(from, to, 1)
+}
+
+// AddWeightedEdge 添加一条带权重的从from到to的有向边（自动添加缺失节点）
+// 无向图中同时添加同权重的反向边
+func (g *Graph[T]) AddWeightedEdge(from, to T, weight float64) {
+	g.addEdge(from, to, weight)
+	if g.undirected && from != to {
+		g.addEdge(to, from, weight)
+	}
+}
+
+// AddEdges 批量添加权重为1的边（自动添加缺失节点），效果与依次调用AddEdge相同
+// 先添加全部端点并统计每个节点新增的出边数量，再一次性为各邻接表行扩容，减少追加时的重新分配
+func (g *Graph[T]) AddEdges(edges ...Edge[T]) {
+	g.mustBeMutable()
+	for _, edge := range edges {
+		g.AddNode(edge.From)
+		g.AddNode(edge.To)
+	}
+	added := make([]int, len(g.nodes))
+	for _, edge := range edges {
+		added[g.nodes[edge.From]]++
+		if g.undirected && edge.From != edge.To {
+			added[g.nodes[edge.To]]++
+		}
+	}
+	for idx, n := range added {
+		if n > 0 {
+			g.adj[idx] = slices.Grow(g.adj[idx], n)
+			g.weights[idx] = slices.Grow(g.weights[idx], n)
+		}
+	}
+	for _, edge := range edges {
+		g.AddEdge(edge.From, edge.To)
+	}
+}
+
+// AddEdgeStrict 添加一条从from到to的边，与AddEdge不同的是不会自动添加缺失节点
+// 任一端点不存在时返回包装了ErrNodeNotFound的错误且不修改图
+func (g *Graph[T]) AddEdgeStrict(from, to T) error {
+	for _, node := range []T{from, to} {
+		if !g.HasNode(node) {
+			return fmt.Errorf("%w: %v", ErrNodeNotFound, node)
+		}
+	}
+	g.AddEdge(from, to)
+	return nil
+}
+
+// AddUndirectedEdge 添加a与b之间的无向边，即同时添加a->b和b->a两条有向边
+// a与b相同时只添加一条自环；EdgeCount将一条无向边计为两条有向边（自环计为一条）
+func (g *Graph[T]) AddUndirectedEdge(a, b T) {
+	g.addEdge(a, b, 1)
+	if a != b {
+		g.addEdge(b, a, 1)
+	}
+}
+
+// addEdge 添加一条带权重的有向边，不考虑无向图模式
+func (g *Graph[T]) addEdge(from, to T, weight float64) {
+	g.mustBeMutable()
 	g.AddNode(from)
 	g.AddNode(to)
 	// 获取节点索引
 	fromIndex := g.nodes[from]
 	toIndex := g.nodes[to]
-	// 添加有向边
+	// 添加有向边及其权重
 	g.adj[fromIndex] = append(g.adj[fromIndex], toIndex)
+	g.weights[fromIndex] = append(g.weights[fromIndex], weight)
 }
 
-// Nodes 返回图中所有节点的切片
-func (g *Graph[T]) Nodes() []T {
-	nodes := make([]T, 0, len(g.nodes))
-	for node := range g.nodes {
-		nodes = append(nodes, node)
+// EdgeWeight 返回从from到to的边的权重及边是否存在
+// 存在平行边时返回最早添加的一条的权重
+func (g *Graph[T]) EdgeWeight(from, to T) (float64, bool) {
+	if !g.HasNode(from) || !g.HasNode(to) {
+		return 0, false
+	}
+	fromIndex := g.nodes[from]
+	pos := slices.Index(g.adj[fromIndex], g.nodes[to])
+	if pos < 0 {
+		return 0, false
+	}
+	return g.weights[fromIndex][pos], true
+}
+
+// RemoveNode 删除节点及其所有出边和入边，返回节点是否存在
+// 删除后排在其后的节点索引依次前移，保持索引连续
+func (g *Graph[T]) RemoveNode(node T) bool {
+	g.mustBeMutable()
+	index, exists := g.nodes[node]
+	if !exists {
+		return false
+	}
+	delete(g.nodes, node)
+	delete(g.nodeAttrs, node)
+	for edge := range g.edgeAttrs {
+		if edge.From == node || edge.To == node {
+			delete(g.edgeAttrs, edge)
+		}
 	}
-	return nodes
+	g.indexToNode = slices.Delete(g.indexToNode, index, index+1)
+	for idx := index; idx < len(g.indexToNode); idx++ {
+		g.nodes[g.indexToNode[idx]] = idx
+	}
+	g.adj = slices.Delete(g.adj, index, index+1)
+	g.weights = slices.Delete(g.weights, index, index+1)
+	// 删除指向该节点的入边，并修正其后节点的索引
+	for from, neighbors := range g.adj {
+		kept := neighbors[:0]
+		keptWeights := g.weights[from][:0]
+		for i, to := range neighbors {
+			if to == index {
+				continue
+			}
+			if to > index {
+				to--
+			}
+			kept = append(kept, to)
+			keptWeights = append(keptWeights, g.weights[from][i])
+		}
+		g.adj[from] = kept
+		g.weights[from] = keptWeights
+	}
+	return true
+}
+
+// RemoveEdge 删除一条从from到to的有向边，返回是否有边被删除
+// 存在平行边时只删除最早添加的一条，便于按多重图建模；节点本身保留
+// 无向图中同时删除一条反向边
+func (g *Graph[T]) RemoveEdge(from, to T) bool {
+	g.mustBeMutable()
+	removed := g.removeEdge(from, to)
+	if removed && g.undirected && from != to {
+		g.removeEdge(to, from)
+	}
+	return removed
+}
+
+// removeEdge 删除一条从from到to的有向边，不考虑无向图模式
+func (g *Graph[T]) removeEdge(from, to T) bool {
+	if !g.HasNode(from) || !g.HasNode(to) {
+		return false
+	}
+	fromIndex := g.nodes[from]
+	pos := slices.Index(g.adj[fromIndex], g.nodes[to])
+	if pos < 0 {
+		return false
+	}
+	g.adj[fromIndex] = slices.Delete(g.adj[fromIndex], pos, pos+1)
+	g.weights[fromIndex] = slices.Delete(g.weights[fromIndex], pos, pos+1)
+	// 最后一条from->to边被删除时清除其属性
+	if !slices.Contains(g.adj[fromIndex], g.nodes[to]) {
+		delete(g.edgeAttrs, Edge[T]{From: from, To: to})
+	}
+	return true
+}
+
+// Nodes 按插入顺序（即索引顺序）返回图中所有节点的切片
+// 返回的切片是副本，修改它不会影响图
+func (g *Graph[T]) Nodes() []T {
+	return slices.Clone(g.indexToNode)
 }
 
 // Edges 返回图中所有边的切片
 // 每条边由起始节点和终止节点组成
 func (g *Graph[T]) Edges() []Edge[T] {
 	edges := make([]Edge[T], 0)
-	// Build index-to-node slice for O(1) lookups
-	indexToNode := make([]T, len(g.nodes))
-	for node, idx := range g.nodes {
-		indexToNode[idx] = node
-	}
 	for from, neighbors := range g.adj {
-		fromNode := indexToNode[from]
+		fromNode := g.indexToNode[from]
 		for _, to := range neighbors {
-			toNode := indexToNode[to]
+			toNode := g.indexToNode[to]
 			edges = append(edges, Edge[T]{From: fromNode, To: toNode})
 		}
 	}
 	return edges
 }
 
+// AllNodes 返回按索引顺序遍历所有节点的迭代器，不额外分配切片，支持提前break
+func (g *Graph[T]) AllNodes() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, node := range g.indexToNode {
+			if !yield(node) {
+				return
+			}
+		}
+	}
+}
+
+// AllEdges 返回遍历所有边的迭代器，顺序与Edges一致，不额外分配切片，支持提前break
+func (g *Graph[T]) AllEdges() iter.Seq[Edge[T]] {
+	return func(yield func(Edge[T]) bool) {
+		for from, neighbors := range g.adj {
+			for _, to := range neighbors {
+				if !yield(Edge[T]{From: g.indexToNode[from], To: g.indexToNode[to]}) {
+					return
+				}
+			}
+		}
+	}
+}
+
 // String 返回图的字符串表示，包含所有节点和邻接表
 // 适用于调试和日志输出
 // 格式为：
@@ -145,16 +378,11 @@ func (g *Graph[T]) Edges() []Edge[T] {
 func (g *Graph[T]) String() string {
 	var builder strings.Builder
 	builder.WriteString("Graph:\n")
-	// 构建索引到节点的映射
-	indexToNode := make([]T, len(g.nodes))
-	for node, idx := range g.nodes {
-		indexToNode[idx] = node
-	}
-	for idx, node := range indexToNode {
+	for idx, node := range g.indexToNode {
 		builder.WriteString(fmt.Sprintf("  %v: [", node))
 		neighbors := g.adj[idx]
 		for i, nIdx := range neighbors {
-			builder.WriteString(fmt.Sprintf("%v", indexToNode[nIdx]))
+			builder.WriteString(fmt.Sprintf("%v", g.indexToNode[nIdx]))
 			if i < len(neighbors)-1 {
 				builder.WriteString(", ")
 			}
@@ -164,12 +392,36 @@ func (g *Graph[T]) String() string {
 	return builder.String()
 }
 
+// IsEmpty 返回图中是否没有任何节点
+func (g *Graph[T]) IsEmpty() bool {
+	return len(g.nodes) == 0
+}
+
+// Clear 删除所有节点、边及属性，使图回到空状态，无向图模式保持不变
+// 已分配的映射与切片会被复用，适合反复清空后重新填充的场景
+func (g *Graph[T]) Clear() {
+	g.mustBeMutable()
+	if g.nodes == nil {
+		g.nodes = make(map[T]int)
+	}
+	clear(g.nodes)
+	clear(g.indexToNode)
+	clear(g.adj)
+	clear(g.weights)
+	g.indexToNode = g.indexToNode[:0]
+	g.adj = g.adj[:0]
+	g.weights = g.weights[:0]
+	clear(g.nodeAttrs)
+	clear(g.edgeAttrs)
+}
+
 // NodeCount 返回图中所有节点的数量
 func (g *Graph[T]) NodeCount() int {
 	return len(g.nodes)
 }
 
 // EdgeCount 返回图中所有边的数量
+// 按有向边计数，无向边计为两条（自环计为一条）
 func (g *Graph[T]) EdgeCount() int {
 	count := 0
 	for _, neighbors := range g.adj {
@@ -179,23 +431,82 @@ func (g *Graph[T]) EdgeCount() int {
 }
 
 // Neighbors 返回指定节点的所有邻居（邻接表直接映射）
+// 节点不存在时返回空列表
 func (g *Graph[T]) Neighbors(node T) []T {
 	// 获取节点索引
-	index := g.nodes[node]
+	index, exists := g.nodes[node]
+	if !exists {
+		return []T{}
+	}
 	// 获取邻居索引列表
 	neighborIndices := g.adj[index]
 	// 映射邻居索引为节点值
 	neighbors := make([]T, 0, len(neighborIndices))
 	for _, neighborIndex := range neighborIndices {
-		// 遍历邻居索引，获取节点值
-		for node, idx := range g.nodes {
-			if idx == neighborIndex {
-				neighbors = append(neighbors, node)
-				break
+		neighbors = append(neighbors, g.indexToNode[neighborIndex])
+	}
+	return neighbors
+}
+
+// WeightedNeighbor 邻居节点及通往它的边的权重
+type WeightedNeighbor[T comparable] struct {
+	Node   T       // 邻居节点
+	Weight float64 // 边权重，未指定权重的边为1
+}
+
+// WeightedNeighbors 按邻接表顺序返回节点的每个出邻居及对应边的权重
+// 平行边各自返回一项；节点不存在时返回空列表
+func (g *Graph[T]) WeightedNeighbors(node T) []WeightedNeighbor[T] {
+	index, exists := g.nodes[node]
+	if !exists {
+		return []WeightedNeighbor[T]{}
+	}
+	neighbors := make([]WeightedNeighbor[T], len(g.adj[index]))
+	for i, neighborIndex := range g.adj[index] {
+		neighbors[i] = WeightedNeighbor[T]{Node: g.indexToNode[neighborIndex], Weight: g.weights[index][i]}
+	}
+	return neighbors
+}
+
+// EachNeighbor 按邻接表顺序对节点的每个邻居调用fn，不分配内存，fn返回false时提前停止
+// 平行边对应的邻居会被多次访问，与Neighbors一致；节点不存在时不执行任何操作
+func (g *Graph[T]) EachNeighbor(node T, fn func(T) bool) {
+	index, exists := g.nodes[node]
+	if !exists {
+		return
+	}
+	for _, neighborIndex := range g.adj[index] {
+		if !fn(g.indexToNode[neighborIndex]) {
+			return
+		}
+	}
+}
+
+// OutDegree 返回节点的出度（平行边按重数计），节点不存在时返回0
+func (g *Graph[T]) OutDegree(node T) int {
+	index, exists := g.nodes[node]
+	if !exists {
+		return 0
+	}
+	return len(g.adj[index])
+}
+
+// InDegree 返回节点的入度（平行边按重数计），节点不存在时返回0
+// 需扫描所有邻接表，时间复杂度O(V+E)；自环同时计入入度和出度
+func (g *Graph[T]) InDegree(node T) int {
+	index, exists := g.nodes[node]
+	if !exists {
+		return 0
+	}
+	degree := 0
+	for _, neighbors := range g.adj {
+		for _, to := range neighbors {
+			if to == index {
+				degree++
 			}
 		}
 	}
-	return neighbors
+	return degree
 }
 
 // HasNode 检查图中是否存在指定节点
@@ -204,6 +515,23 @@ func (g *Graph[T]) HasNode(node T) bool {
 	return exists
 }
 
+// Index 返回节点的内部索引及节点是否存在
+// 索引与Edges、ToDTO、AdjacencyMatrix使用的顺序一致，为[0, NodeCount())内的整数；
+// RemoveNode会压缩索引，其后节点的索引随之减一
+func (g *Graph[T]) Index(node T) (int, bool) {
+	index, exists := g.nodes[node]
+	return index, exists
+}
+
+// NodeAt 返回内部索引对应的节点，索引越界时返回零值和false，与Index互为逆运算
+func (g *Graph[T]) NodeAt(index int) (T, bool) {
+	if index < 0 || index >= len(g.indexToNode) {
+		var zero T
+		return zero, false
+	}
+	return g.indexToNode[index], true
+}
+
 // HasEdge 检查是否存在从from到to的有向边
 func (g *Graph[T]) HasEdge(from, to T) bool {
 	if !g.HasNode(from) || !g.HasNode(to) {
@@ -217,15 +545,53 @@ func (g *Graph[T]) HasEdge(from, to T) bool {
 }
 
 // ToDTO 将图转换为GraphDTO格式，适用于序列化
-// 返回的DTO包含所有节点和邻接表
+// 返回的DTO包含所有节点和邻接表，Nodes按索引顺序排列，保证Adj[i]对应Nodes[i]
+// 邻接表为深拷贝，修改DTO不会影响原图；存在节点属性或非默认权重时一并导出
 func (g *Graph[T]) ToDTO() *GraphDTO {
 	nodes := make([]interface{}, 0, len(g.nodes))
-	for node := range g.nodes {
+	for _, node := range g.indexToNode {
 		nodes = append(nodes, node)
 	}
 
-	return &GraphDTO{
+	dto := &GraphDTO{
 		Nodes: nodes,
-		Adj:   g.adj,
+		Adj:   g.cloneAdj(),
+	}
+	if len(g.nodeAttrs) > 0 {
+		dto.NodeAttrs = make([]map[string]any, len(g.indexToNode))
+		for idx, node := range g.indexToNode {
+			dto.NodeAttrs[idx] = maps.Clone(g.nodeAttrs[node])
+		}
+	}
+	dto.Weights = g.cloneWeights()
+	return dto
+}
+
+// cloneWeights 返回与邻接表对应的权重表深拷贝，所有边权重均为默认值1时返回nil
+func (g *Graph[T]) cloneWeights() [][]float64 {
+	weighted := false
+	for _, row := range g.weights {
+		if slices.ContainsFunc(row, func(w float64) bool { return w != 1 }) {
+			weighted = true
+			break
+		}
+	}
+	if !weighted {
+		return nil
+	}
+	weights := make([][]float64, len(g.weights))
+	for i, row := range g.weights {
+		weights[i] = append(make([]float64, 0, len(row)), row...)
+	}
+	return weights
+}
+
+// cloneAdj 返回邻接表的深拷贝，避免外部修改内部状态
+// 没有邻居的行为空切片而非nil，序列化时输出[]
+func (g *Graph[T]) cloneAdj() [][]int {
+	adj := make([][]int, len(g.adj))
+	for i, neighbors := range g.adj {
+		adj[i] = append(make([]int, 0, len(neighbors)), neighbors...)
 	}
+	return adj
 }
@@ -1,6 +1,7 @@
 package ggraph_test
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/nosusume/ggraph"
@@ -66,6 +67,32 @@ func TestEdgeCountAndNodeCount(t *testing.T) {
 	assert.Equal(t, 2, graph.EdgeCount(), "边数量应为2")
 }
 
+func TestIsEmptyAndClear(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	assert.True(t, graph.IsEmpty(), "新建的图应为空")
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", "C")
+	graph.SetNodeAttr("A", "color", "red")
+	assert.False(t, graph.IsEmpty(), "添加节点后图不应为空")
+
+	graph.Clear()
+	assert.True(t, graph.IsEmpty(), "清空后图应为空")
+	assert.Zero(t, graph.NodeCount(), "清空后节点数量应为0")
+	assert.Zero(t, graph.EdgeCount(), "清空后边数量应为0")
+	assert.Empty(t, graph.Nodes(), "清空后不应有节点")
+
+	graph.AddEdge("C", "D")
+	assert.Equal(t, []string{"C", "D"}, graph.Nodes(), "清空后应可继续使用")
+	assert.Equal(t, []string{"D"}, graph.Neighbors("C"), "清空后不应残留旧的邻接关系")
+	_, ok := graph.NodeAttr("A", "color")
+	assert.False(t, ok, "清空后不应残留节点属性")
+
+	var zero ggraph.Graph[int]
+	zero.Clear()
+	zero.AddEdge(1, 2)
+	assert.True(t, zero.HasEdge(1, 2), "零值图清空后应可使用")
+}
+
 func TestStringRepresentation(t *testing.T) {
 	graph := ggraph.NewGraph[string]()
 	graph.AddEdge("A", "B")
@@ -74,6 +101,25 @@ func TestStringRepresentation(t *testing.T) {
 	assert.Contains(t, str, "B: []", "字符串表示应包含B: []")
 }
 
+func TestNodesInsertionOrder(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	order := []string{"delta", "alpha", "charlie", "bravo", "echo"}
+	for _, node := range order {
+		graph.AddNode(node)
+	}
+	graph.AddEdge("alpha", "foxtrot")
+
+	expected := append(slices.Clone(order), "foxtrot")
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, expected, graph.Nodes(), "Nodes应按插入顺序返回")
+	}
+	assert.Equal(t, "Graph:\n  delta: []\n  alpha: [foxtrot]\n  charlie: []\n  bravo: []\n  echo: []\n  foxtrot: []\n",
+		graph.String(), "String应按插入顺序输出")
+
+	graph.RemoveNode("charlie")
+	assert.Equal(t, []string{"delta", "alpha", "bravo", "echo", "foxtrot"}, graph.Nodes(), "删除节点后其余节点应保持原有顺序")
+}
+
 func TestToDTOAndNewGraphByDTO(t *testing.T) {
 	graph := ggraph.NewGraph[int]()
 	graph.AddEdge(1, 2)
@@ -83,6 +129,52 @@ func TestToDTOAndNewGraphByDTO(t *testing.T) {
 	assert.True(t, newGraph.HasEdge(1, 2), "DTO恢复的图应包含边1->2")
 }
 
+func TestToDTORoundTripPreservesEdges(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", "C")
+	graph.AddEdge("C", "D")
+	graph.AddEdge("D", "E")
+	graph.AddEdge("E", "A")
+	graph.AddEdge("A", "D")
+	graph.AddEdge("F", "C")
+	graph.AddNode("G")
+
+	dto := graph.ToDTO()
+	for i, node := range dto.Nodes {
+		assert.Len(t, dto.Adj[i], len(graph.Neighbors(node.(string))), "Adj[%d]应对应节点%v", i, node)
+	}
+	newGraph := ggraph.NewGraphByDTO(dto)
+	assert.Equal(t, graph.NodeCount(), newGraph.NodeCount(), "节点数量应一致")
+	assert.Equal(t, graph.EdgeCount(), newGraph.EdgeCount(), "边数量应一致")
+	for _, edge := range graph.Edges() {
+		assert.True(t, newGraph.HasEdge(edge.From, edge.To), "边%v->%v应在往返后保留", edge.From, edge.To)
+	}
+}
+
+func TestToDTORoundTripPreservesWeights(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddWeightedEdge("A", "B", 2.5)
+	graph.AddWeightedEdge("A", "C", -1)
+	graph.AddEdge("B", "C")
+	graph.AddWeightedEdge("A", "B", 7) // 平行边权重各自保留
+
+	dto := graph.ToDTO()
+	assert.Equal(t, [][]float64{{2.5, -1, 7}, {1}, {}}, dto.Weights, "Weights应与Adj逐项对应")
+	newGraph := ggraph.NewGraphByDTO(dto)
+	for _, edge := range []ggraph.Edge[any]{{From: "A", To: "C"}, {From: "B", To: "C"}} {
+		expected, _ := graph.EdgeWeight(edge.From.(string), edge.To.(string))
+		weight, ok := newGraph.EdgeWeight(edge.From, edge.To)
+		assert.True(t, ok, "边%v->%v应在往返后保留", edge.From, edge.To)
+		assert.Equal(t, expected, weight, "边%v->%v的权重应在往返后保留", edge.From, edge.To)
+	}
+	assert.Equal(t, dto.Weights, newGraph.ToDTO().Weights, "平行边的权重应在往返后保留")
+
+	unweighted := ggraph.NewGraph[int]()
+	unweighted.AddEdge(1, 2)
+	assert.Nil(t, unweighted.ToDTO().Weights, "所有权重为默认值时应省略Weights")
+}
+
 type testNode struct {
 	val   int
 	edges []ggraph.Edge[int]
@@ -119,3 +211,355 @@ func TestNeighborsNonExistentNode(t *testing.T) {
 	neighbors := graph.Neighbors(999)
 	assert.Empty(t, neighbors, "不存在节点应返回空邻居列表")
 }
+
+func TestRemoveNode(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", "C")
+	graph.AddEdge("C", "A")
+	graph.AddEdge("A", "C")
+	graph.AddEdge("C", "D")
+
+	assert.True(t, graph.RemoveNode("B"), "删除存在的节点应返回true")
+	assert.False(t, graph.HasNode("B"), "节点B应已被删除")
+	assert.False(t, graph.HasEdge("A", "B"), "指向B的边应已删除")
+	assert.False(t, graph.HasEdge("B", "C"), "B的出边应已删除")
+	assert.True(t, graph.HasEdge("C", "A"), "边C->A应保留")
+	assert.True(t, graph.HasEdge("A", "C"), "边A->C应保留")
+	assert.True(t, graph.HasEdge("C", "D"), "边C->D应保留")
+	assert.Equal(t, 3, graph.NodeCount(), "节点数量应为3")
+	assert.Equal(t, 3, graph.EdgeCount(), "边数量应为3")
+	assert.ElementsMatch(t, []string{"A", "D"}, graph.Neighbors("C"), "C的邻居应为A和D")
+	assert.Contains(t, graph.String(), "D: []", "字符串表示应保持一致")
+	assert.ElementsMatch(t, []ggraph.Edge[string]{{From: "C", To: "A"}, {From: "A", To: "C"}, {From: "C", To: "D"}},
+		graph.Edges(), "剩余边应完整保留")
+}
+
+func TestRemoveNodeMissing(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	graph.AddEdge(1, 2)
+	assert.False(t, graph.RemoveNode(3), "删除不存在的节点应返回false")
+	assert.Equal(t, 2, graph.NodeCount(), "节点数量不应变化")
+}
+
+func TestRemoveEdge(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	graph.AddEdge(1, 2)
+	graph.AddEdge(1, 2) // 平行边
+	graph.AddEdge(2, 3)
+
+	assert.False(t, graph.RemoveEdge(3, 1), "删除不存在的边应返回false")
+	assert.False(t, graph.RemoveEdge(1, 4), "端点不存在时应返回false")
+	assert.Equal(t, 3, graph.EdgeCount(), "删除失败时边数量不应变化")
+
+	assert.True(t, graph.RemoveEdge(1, 2), "删除存在的边应返回true")
+	assert.True(t, graph.HasEdge(1, 2), "平行边只应删除一条")
+	assert.Equal(t, 2, graph.EdgeCount(), "边数量应为2")
+
+	assert.True(t, graph.RemoveEdge(1, 2), "应可删除剩余的平行边")
+	assert.False(t, graph.HasEdge(1, 2), "边1->2应已全部删除")
+	assert.Equal(t, 1, graph.EdgeCount(), "边数量应为1")
+	assert.True(t, graph.HasNode(1), "删除边后节点应保留")
+	assert.True(t, graph.HasNode(2), "删除边后节点应保留")
+}
+
+func TestEdgeWeight(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddWeightedEdge("A", "B", 2.5)
+	graph.AddEdge("B", "C")
+
+	weight, ok := graph.EdgeWeight("A", "B")
+	assert.True(t, ok, "边A->B应存在")
+	assert.Equal(t, 2.5, weight, "边A->B的权重应为2.5")
+
+	weight, ok = graph.EdgeWeight("B", "C")
+	assert.True(t, ok, "边B->C应存在")
+	assert.Equal(t, 1.0, weight, "AddEdge添加的边权重默认为1")
+
+	_, ok = graph.EdgeWeight("C", "A")
+	assert.False(t, ok, "不存在的边应返回false")
+	assert.True(t, graph.HasEdge("A", "B"), "带权边应能被HasEdge检测到")
+}
+
+func TestEdgeWeightAfterRemoval(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddWeightedEdge("A", "B", 2)
+	graph.AddWeightedEdge("A", "C", 3)
+	graph.AddWeightedEdge("C", "D", 4)
+	graph.AddWeightedEdge("A", "D", 5)
+
+	graph.RemoveEdge("A", "B")
+	graph.RemoveNode("C")
+	weight, ok := graph.EdgeWeight("A", "D")
+	assert.True(t, ok, "边A->D应存在")
+	assert.Equal(t, 5.0, weight, "删除其他边和节点后权重应保持对应")
+}
+
+func TestAddUndirectedEdge(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddUndirectedEdge("A", "B")
+	graph.AddUndirectedEdge("C", "C")
+
+	assert.True(t, graph.HasEdge("A", "B"), "边A->B应存在")
+	assert.True(t, graph.HasEdge("B", "A"), "边B->A应存在")
+	assert.True(t, graph.HasEdge("C", "C"), "自环应存在")
+	assert.Equal(t, 3, graph.EdgeCount(), "无向边计为两条，自环只计一条")
+	assert.False(t, graph.IsUndirected(), "普通图不应为无向图")
+}
+
+func TestUndirectedGraph(t *testing.T) {
+	graph := ggraph.NewUndirectedGraph[int]()
+	graph.AddEdge(1, 2)
+	graph.AddWeightedEdge(2, 3, 4)
+	graph.AddEdge(3, 3)
+
+	assert.True(t, graph.IsUndirected(), "应为无向图")
+	for _, edge := range graph.Edges() {
+		assert.True(t, graph.HasEdge(edge.To, edge.From), "边%v->%v应对称", edge.From, edge.To)
+	}
+	weight, ok := graph.EdgeWeight(3, 2)
+	assert.True(t, ok, "反向边应存在")
+	assert.Equal(t, 4.0, weight, "反向边权重应相同")
+	assert.Equal(t, 5, graph.EdgeCount(), "两条无向边加一个自环应计为5")
+
+	assert.True(t, graph.RemoveEdge(2, 1), "删除无向边应返回true")
+	assert.False(t, graph.HasEdge(1, 2), "删除后两个方向均不应存在")
+	assert.False(t, graph.HasEdge(2, 1), "删除后两个方向均不应存在")
+	assert.Equal(t, 3, graph.EdgeCount(), "删除后边数量应为3")
+}
+
+func TestNeighborsAfterRemoveNode(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	for i := 0; i < 10; i++ {
+		graph.AddEdge(0, i)
+	}
+	graph.RemoveNode(3)
+	graph.RemoveNode(7)
+
+	assert.Equal(t, []int{0, 1, 2, 4, 5, 6, 8, 9}, graph.Neighbors(0), "删除节点后邻居应与节点值对应")
+	assert.True(t, graph.HasNode(9), "索引前移后节点9应存在")
+	assert.True(t, graph.HasEdge(0, 9), "索引前移后边0->9应存在")
+}
+
+func BenchmarkNeighbors(b *testing.B) {
+	const n = 5000
+	graph := ggraph.NewGraph[int]()
+	for i := 0; i < n; i++ {
+		for j := 1; j <= 10; j++ {
+			graph.AddEdge(i, (i+j)%n)
+		}
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		graph.Neighbors(i % n)
+	}
+}
+
+func TestEachNeighbor(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	graph.AddEdge(1, 2)
+	graph.AddEdge(1, 3)
+	graph.AddEdge(1, 4)
+
+	var visited []int
+	graph.EachNeighbor(1, func(n int) bool {
+		visited = append(visited, n)
+		return true
+	})
+	assert.Equal(t, graph.Neighbors(1), visited, "应访问与Neighbors相同的邻居")
+
+	visited = nil
+	graph.EachNeighbor(1, func(n int) bool {
+		visited = append(visited, n)
+		return len(visited) < 2
+	})
+	assert.Equal(t, []int{2, 3}, visited, "fn返回false时应提前停止")
+
+	graph.EachNeighbor(99, func(int) bool {
+		t.Fatal("节点不存在时不应调用fn")
+		return true
+	})
+}
+
+func BenchmarkEachNeighbor(b *testing.B) {
+	const n = 5000
+	graph := ggraph.NewGraph[int]()
+	for i := 0; i < n; i++ {
+		for j := 1; j <= 10; j++ {
+			graph.AddEdge(i, (i+j)%n)
+		}
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		graph.EachNeighbor(i%n, func(int) bool { return true })
+	}
+}
+
+func TestAllNodesAndAllEdges(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	graph.AddEdge(1, 2)
+	graph.AddEdge(2, 3)
+	graph.AddEdge(3, 1)
+
+	var nodes []int
+	for node := range graph.AllNodes() {
+		nodes = append(nodes, node)
+	}
+	assert.ElementsMatch(t, graph.Nodes(), nodes, "AllNodes应遍历全部节点")
+
+	var edges []ggraph.Edge[int]
+	for edge := range graph.AllEdges() {
+		edges = append(edges, edge)
+	}
+	assert.Equal(t, graph.Edges(), edges, "AllEdges应与Edges顺序一致")
+}
+
+func TestAllNodesAndAllEdgesBreak(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	graph.AddEdge(1, 2)
+	graph.AddEdge(2, 3)
+	graph.AddEdge(3, 1)
+
+	count := 0
+	for range graph.AllNodes() {
+		count++
+		if count == 2 {
+			break
+		}
+	}
+	assert.Equal(t, 2, count, "break后AllNodes应停止")
+
+	count = 0
+	for range graph.AllEdges() {
+		count++
+		break
+	}
+	assert.Equal(t, 1, count, "break后AllEdges应停止")
+}
+
+func TestDegree(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "X")
+	graph.AddEdge("B", "X")
+	graph.AddEdge("C", "X")
+	graph.AddEdge("X", "A")
+	graph.AddEdge("X", "B")
+	graph.AddEdge("X", "X") // 自环
+
+	assert.Equal(t, 4, graph.InDegree("X"), "X的入度应为4（含自环）")
+	assert.Equal(t, 3, graph.OutDegree("X"), "X的出度应为3（含自环）")
+	assert.Equal(t, 1, graph.InDegree("A"), "A的入度应为1")
+	assert.Equal(t, 0, graph.InDegree("C"), "C的入度应为0")
+	assert.Equal(t, 0, graph.InDegree("Z"), "不存在的节点入度应为0")
+	assert.Equal(t, 0, graph.OutDegree("Z"), "不存在的节点出度应为0")
+}
+
+func TestAddEdgeStrict(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddNode("A")
+
+	err := graph.AddEdgeStrict("A", "B")
+	assert.ErrorIs(t, err, ggraph.ErrNodeNotFound, "终点不存在时应返回ErrNodeNotFound")
+	assert.ErrorContains(t, err, "B", "错误信息应包含缺失的节点")
+	assert.ErrorIs(t, graph.AddEdgeStrict("C", "A"), ggraph.ErrNodeNotFound, "起点不存在时应返回ErrNodeNotFound")
+	assert.False(t, graph.HasNode("B"), "不应自动添加缺失节点")
+	assert.Zero(t, graph.EdgeCount(), "失败时不应添加边")
+
+	graph.AddNode("B")
+	assert.NoError(t, graph.AddEdgeStrict("A", "B"), "两端点均存在时应成功")
+	assert.True(t, graph.HasEdge("A", "B"), "边A->B应存在")
+}
+
+func TestIndexAndNodeAt(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", "C")
+	graph.AddNode("D")
+	graph.RemoveNode("B")
+
+	for _, node := range graph.Nodes() {
+		index, ok := graph.Index(node)
+		assert.True(t, ok, "存在的节点应有索引: %s", node)
+		back, ok := graph.NodeAt(index)
+		assert.True(t, ok, "有效索引应对应节点: %d", index)
+		assert.Equal(t, node, back, "Index与NodeAt应互为逆运算")
+	}
+	index, _ := graph.Index("C")
+	assert.Equal(t, "C", graph.ToDTO().Nodes[index], "索引应与ToDTO的顺序一致")
+
+	_, ok := graph.Index("X")
+	assert.False(t, ok, "节点不存在时应返回false")
+	_, ok = graph.NodeAt(3)
+	assert.False(t, ok, "索引越界时应返回false")
+	_, ok = graph.NodeAt(-1)
+	assert.False(t, ok, "负索引应返回false")
+}
+
+func TestAddNodesAndAddEdges(t *testing.T) {
+	edges := []ggraph.Edge[int]{{From: 1, To: 2}, {From: 2, To: 3}, {From: 1, To: 2}, {From: 3, To: 3}, {From: 4, To: 1}}
+	for _, undirected := range []bool{false, true} {
+		batch, single := ggraph.NewGraph[int](), ggraph.NewGraph[int]()
+		if undirected {
+			batch, single = ggraph.NewUndirectedGraph[int](), ggraph.NewUndirectedGraph[int]()
+		}
+		batch.AddNodes(5, 1, 5)
+		batch.AddEdges(edges...)
+		for _, node := range []int{5, 1, 5} {
+			single.AddNode(node)
+		}
+		for _, edge := range edges {
+			single.AddEdge(edge.From, edge.To)
+		}
+
+		assert.Equal(t, single.Nodes(), batch.Nodes(), "批量添加的节点顺序应与逐个添加一致")
+		assert.Equal(t, single.Edges(), batch.Edges(), "批量添加的边应与逐个添加一致")
+		assert.Equal(t, single.String(), batch.String(), "批量添加的结果应与逐个添加一致")
+	}
+}
+
+// newBulkEdges 构建n条在n/10个节点之间的边
+func newBulkEdges(n int) []ggraph.Edge[int] {
+	edges := make([]ggraph.Edge[int], n)
+	for i := range edges {
+		edges[i] = ggraph.Edge[int]{From: i % (n / 10), To: (i * 7) % (n / 10)}
+	}
+	return edges
+}
+
+func BenchmarkAddEdgesBulk(b *testing.B) {
+	edges := newBulkEdges(100000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ggraph.NewGraph[int]().AddEdges(edges...)
+	}
+}
+
+func BenchmarkAddEdgeOneByOne(b *testing.B) {
+	edges := newBulkEdges(100000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		graph := ggraph.NewGraph[int]()
+		for _, edge := range edges {
+			graph.AddEdge(edge.From, edge.To)
+		}
+	}
+}
+
+func TestWeightedNeighbors(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddWeightedEdge("A", "B", 2.5)
+	graph.AddEdge("A", "C")
+	graph.AddWeightedEdge("A", "B", -1)
+
+	expected := []ggraph.WeightedNeighbor[string]{
+		{Node: "B", Weight: 2.5},
+		{Node: "C", Weight: 1},
+		{Node: "B", Weight: -1},
+	}
+	assert.Equal(t, expected, graph.WeightedNeighbors("A"), "应按添加顺序返回邻居及其权重，未指定权重时为1")
+	assert.Empty(t, graph.WeightedNeighbors("C"), "没有出边时应返回空列表")
+	assert.Empty(t, graph.WeightedNeighbors("X"), "节点不存在时应返回空列表")
+}
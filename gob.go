@@ -0,0 +1,37 @@
+package ggraph
+
+import (
+	"bytes"
+	"encoding/gob"
+)
+
+// GobEncode 实现gob.GobEncoder，按索引顺序编码节点、邻接表、节点属性与边权重
+// 节点属性值以interface形式编码，非基本类型的属性值需事先调用gob.Register注册
+func (g *Graph[T]) GobEncode() ([]byte, error) {
+	data := graphData[T]{
+		Nodes:   g.indexToNode,
+		Adj:     g.adj,
+		Weights: g.weights,
+	}
+	if len(g.nodeAttrs) > 0 {
+		data.NodeAttrs = make([]map[string]any, len(g.indexToNode))
+		for idx, node := range g.indexToNode {
+			data.NodeAttrs[idx] = g.nodeAttrs[node]
+		}
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode 实现gob.GobDecoder，从GobEncode的输出恢复图，原有内容会被清空
+// 校验规则与UnmarshalJSON一致，解码失败时不修改g
+func (g *Graph[T]) GobDecode(b []byte) error {
+	var decoded graphData[T]
+	if err := gob.NewDecoder(bytes.NewReader(b)).Decode(&decoded); err != nil {
+		return err
+	}
+	return g.load(decoded)
+}
@@ -0,0 +1,84 @@
+package ggraph_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/nosusume/ggraph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGobRoundTrip(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "B")
+	graph.AddWeightedEdge("B", "C", 2.5)
+	graph.AddEdge("C", "A")
+	graph.AddEdge("A", "B")
+	graph.AddNode("D")
+	graph.SetNodeAttr("D", "color", "red")
+
+	var buf bytes.Buffer
+	assert.NoError(t, gob.NewEncoder(&buf).Encode(graph), "编码不应出错")
+	decoded := ggraph.NewGraph[string]()
+	assert.NoError(t, gob.NewDecoder(&buf).Decode(decoded), "解码不应出错")
+
+	assert.True(t, graph.Equal(decoded), "往返后图应相等")
+	assert.Equal(t, graph.Nodes(), decoded.Nodes(), "节点应按原顺序恢复")
+	weight, _ := decoded.EdgeWeight("B", "C")
+	assert.Equal(t, 2.5, weight, "边权重应保留")
+	value, _ := decoded.NodeAttr("D", "color")
+	assert.Equal(t, "red", value, "节点属性应保留")
+
+	empty := ggraph.NewGraph[string]()
+	data, err := empty.GobEncode()
+	assert.NoError(t, err, "空图编码不应出错")
+	assert.NoError(t, decoded.GobDecode(data), "空图解码不应出错")
+	assert.Zero(t, decoded.NodeCount(), "解码空图后应清空原有内容")
+	assert.Error(t, decoded.GobDecode([]byte("invalid")), "数据无效时应返回错误")
+}
+
+// newLargeGraph 构建一个包含n个节点、每个节点10条出边的图
+func newLargeGraph(n int) *ggraph.Graph[string] {
+	graph := ggraph.NewGraph[string]()
+	names := make([]string, n)
+	for i := range names {
+		names[i] = "node-" + strconv.Itoa(i)
+	}
+	for i := range names {
+		for j := 1; j <= 10; j++ {
+			graph.AddEdge(names[i], names[(i+j)%n])
+		}
+	}
+	return graph
+}
+
+func BenchmarkGobRoundTrip(b *testing.B) {
+	graph := newLargeGraph(20000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		data, err := graph.GobEncode()
+		if err != nil {
+			b.Fatal(err)
+		}
+		if err := ggraph.NewGraph[string]().GobDecode(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkJSONRoundTrip(b *testing.B) {
+	graph := newLargeGraph(20000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		data, err := json.Marshal(graph)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if err := json.Unmarshal(data, ggraph.NewGraph[string]()); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package ggraph
+
+// MaxHamiltonianNodes HasHamiltonianPath使用状态压缩动态规划的最大节点数，超过时改用回溯搜索
+const MaxHamiltonianNodes = 20
+
+// HasHamiltonianPath 判断有向图中是否存在经过每个节点恰好一次的路径
+// 节点数不超过MaxHamiltonianNodes时使用状态压缩动态规划，时间复杂度O(2^N·(N+E))、空间复杂度O(2^N)；
+// 超过时改用回溯DFS，结果同样正确且只占用O(N)的额外空间，但最坏情况下耗时随N阶乘增长
+// 空图或弱不连通的图返回false
+func (g *Graph[T]) HasHamiltonianPath() bool {
+	n := len(g.nodes)
+	if n == 0 || g.ComponentCount() > 1 {
+		return false
+	}
+	if n > MaxHamiltonianNodes {
+		return g.hamiltonianBacktrack()
+	}
+	// ends[mask]为恰好经过mask中节点的路径所有可能终点的集合
+	ends := make([]uint32, 1<<n)
+	for v := 0; v < n; v++ {
+		ends[1<<v] = 1 << v
+	}
+	for mask := 1; mask < len(ends); mask++ {
+		if ends[mask] == 0 {
+			continue
+		}
+		for v := 0; v < n; v++ {
+			if ends[mask]&(1<<v) == 0 {
+				continue
+			}
+			for _, u := range g.adj[v] {
+				if mask&(1<<u) == 0 {
+					ends[mask|1<<u] |= 1 << u
+				}
+			}
+		}
+	}
+	return ends[len(ends)-1] != 0
+}
+
+// hamiltonianBacktrack 从每个节点出发进行迭代回溯DFS，寻找经过全部节点的简单路径
+func (g *Graph[T]) hamiltonianBacktrack() bool {
+	n := len(g.nodes)
+	onPath := make([]bool, n)
+	for start := range g.adj {
+		onPath[start] = true
+		stack := []dfsFrame{{index: start}}
+		for len(stack) > 0 {
+			if len(stack) == n {
+				return true
+			}
+			top := &stack[len(stack)-1]
+			if top.next == len(g.adj[top.index]) {
+				onPath[top.index] = false
+				stack = stack[:len(stack)-1]
+				continue
+			}
+			next := g.adj[top.index][top.next]
+			top.next++
+			if !onPath[next] {
+				onPath[next] = true
+				stack = append(stack, dfsFrame{index: next})
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,59 @@
+package ggraph_test
+
+import (
+	"testing"
+
+	"github.com/nosusume/ggraph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHasHamiltonianPath(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	graph.AddEdge(2, 0)
+	graph.AddEdge(0, 3)
+	graph.AddEdge(3, 1)
+	graph.AddEdge(0, 1)
+	assert.True(t, graph.HasHamiltonianPath(), "有向路径2->0->3->1应存在")
+
+	star := ggraph.NewGraph[int]()
+	star.AddEdge(0, 1)
+	star.AddEdge(0, 2)
+	star.AddEdge(0, 3)
+	assert.False(t, star.HasHamiltonianPath(), "星形有向图不存在哈密顿路径")
+}
+
+func TestHasHamiltonianPathEdgeCases(t *testing.T) {
+	assert.False(t, ggraph.NewGraph[int]().HasHamiltonianPath(), "空图应返回false")
+
+	graph := ggraph.NewGraph[int]()
+	graph.AddEdge(0, 1)
+	graph.AddEdge(2, 3)
+	assert.False(t, graph.HasHamiltonianPath(), "不连通图应返回false")
+
+	single := ggraph.NewGraph[int]()
+	single.AddNode(0)
+	assert.True(t, single.HasHamiltonianPath(), "单节点图应存在哈密顿路径")
+
+	chain := ggraph.NewGraph[int]()
+	for i := ggraph.MaxHamiltonianNodes - 1; i > 0; i-- {
+		chain.AddEdge(i, i-1)
+	}
+	assert.True(t, chain.HasHamiltonianPath(), "节点数达到上限的链应存在哈密顿路径")
+}
+
+func TestHasHamiltonianPathAboveLimit(t *testing.T) {
+	n := ggraph.MaxHamiltonianNodes + 5
+	assert.True(t, ggraph.CompleteGraph(ggraph.MaxHamiltonianNodes+1).HasHamiltonianPath(), "超过上限的完全图应存在哈密顿路径")
+
+	chain := ggraph.NewGraph[int]()
+	for i := n - 1; i > 0; i-- {
+		chain.AddEdge(i, i-1)
+	}
+	assert.True(t, chain.HasHamiltonianPath(), "超过上限的链应存在哈密顿路径")
+
+	star := ggraph.NewGraph[int]()
+	for leaf := 1; leaf < n; leaf++ {
+		star.AddEdge(0, leaf)
+	}
+	assert.False(t, star.HasHamiltonianPath(), "超过上限的星形图不存在哈密顿路径")
+}
@@ -0,0 +1,79 @@
+package ggraph
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// graphData 序列化使用的泛型图结构，布局与GraphDTO一致，节点保持原类型
+type graphData[T comparable] struct {
+	Nodes     []T              `json:"nodes" yaml:"nodes"`
+	Adj       [][]int          `json:"adj" yaml:"adj"`
+	NodeAttrs []map[string]any `json:"node_attrs,omitempty" yaml:"node_attrs,omitempty"`
+	Weights   [][]float64      `json:"weights,omitempty" yaml:"weights,omitempty"`
+}
+
+// MarshalJSON 实现json.Marshaler，输出与ToDTO相同的按索引排列的布局
+func (g *Graph[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(g.ToDTO())
+}
+
+// UnmarshalJSON 实现json.Unmarshaler，从MarshalJSON的输出恢复图，原有内容会被清空
+// 节点值按T的JSON规则解码，因此T需为encoding/json可解码的类型（字符串、数字、
+// 导出字段的结构体、数组等）；Graph[any]中的数字节点会被解码为float64，
+// 而JSON数组和对象会被解码为不可比较的[]any和map[string]any，因此Graph[any]不能包含此类节点，
+// 遇到时返回错误（如需反序列化GridGraph等数组节点的图，请使用Graph[[2]int]等具体类型）
+func (g *Graph[T]) UnmarshalJSON(data []byte) error {
+	var decoded graphData[T]
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	return g.load(decoded)
+}
+
+// load 以data的内容重建图，保留g的无向图模式
+// 邻接表按有向边原样恢复，缺少权重表时边权重为1
+// 节点不可比较（无法作为映射键）、节点重复、邻居索引越界或权重表与邻接表形状不一致时返回错误且不修改g
+func (g *Graph[T]) load(data graphData[T]) error {
+	g.mustBeMutable()
+	loaded := NewGraph[T]()
+	loaded.undirected = g.undirected
+	for _, node := range data.Nodes {
+		// T为接口类型时，解码出的切片或映射等动态值无法作为映射键，直接使用会panic
+		if value := reflect.ValueOf(any(node)); value.IsValid() && !value.Comparable() {
+			return fmt.Errorf("ggraph: node %v of type %T is not comparable", node, node)
+		}
+		if loaded.HasNode(node) {
+			return fmt.Errorf("ggraph: duplicate node %v", node)
+		}
+		loaded.AddNode(node)
+	}
+	if len(data.Adj) > len(data.Nodes) {
+		return fmt.Errorf("ggraph: adjacency has %d rows for %d nodes", len(data.Adj), len(data.Nodes))
+	}
+	if data.Weights != nil && len(data.Weights) != len(data.Adj) {
+		return fmt.Errorf("ggraph: weights have %d rows for %d adjacency rows", len(data.Weights), len(data.Adj))
+	}
+	for from, neighbors := range data.Adj {
+		if data.Weights != nil && len(data.Weights[from]) != len(neighbors) {
+			return fmt.Errorf("ggraph: weights row %d has %d entries for %d edges", from, len(data.Weights[from]), len(neighbors))
+		}
+		for i, to := range neighbors {
+			if to < 0 || to >= len(data.Nodes) {
+				return fmt.Errorf("ggraph: adjacency index %d out of range", to)
+			}
+			loaded.addEdge(data.Nodes[from], data.Nodes[to], dtoWeight(data.Weights, from, i))
+		}
+	}
+	for i, attrs := range data.NodeAttrs {
+		if i >= len(data.Nodes) {
+			break
+		}
+		for key, value := range attrs {
+			loaded.SetNodeAttr(data.Nodes[i], key, value)
+		}
+	}
+	*g = *loaded
+	return nil
+}
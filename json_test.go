@@ -0,0 +1,99 @@
+package ggraph_test
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/nosusume/ggraph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONRoundTripString(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", "C")
+	graph.AddEdge("C", "A")
+	graph.AddEdge("A", "B")
+	graph.AddNode("D")
+	graph.SetNodeAttr("D", "color", "red")
+
+	data, err := json.Marshal(graph)
+	assert.NoError(t, err, "序列化不应出错")
+	assert.JSONEq(t, `{"nodes":["A","B","C","D"],"adj":[[1,1],[2],[0],[]],"node_attrs":[null,null,null,{"color":"red"}]}`,
+		string(data), "应输出按索引排列的DTO布局")
+
+	decoded := ggraph.NewGraph[string]()
+	assert.NoError(t, json.Unmarshal(data, decoded), "反序列化不应出错")
+	assert.True(t, graph.Equal(decoded), "往返后图应相等")
+	value, _ := decoded.NodeAttr("D", "color")
+	assert.Equal(t, "red", value, "节点属性应保留")
+}
+
+func TestJSONRoundTripInt(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	graph.AddEdge(10, 20)
+	graph.AddEdge(20, 30)
+	graph.AddEdge(30, 30)
+
+	data, err := json.Marshal(graph)
+	assert.NoError(t, err, "序列化不应出错")
+
+	var decoded ggraph.Graph[int]
+	assert.NoError(t, json.Unmarshal(data, &decoded), "零值图也应可反序列化")
+	assert.True(t, graph.Equal(&decoded), "往返后图应相等")
+	assert.Equal(t, []int{10, 20, 30}, slices.Collect(decoded.AllNodes()), "节点应按原顺序恢复")
+
+	decoded.AddEdge(40, 10)
+	assert.True(t, decoded.HasEdge(40, 10), "反序列化后的图应可继续使用")
+}
+
+func TestJSONRoundTripWeights(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddWeightedEdge("A", "B", 0.5)
+	graph.AddEdge("B", "C")
+
+	data, err := json.Marshal(graph)
+	assert.NoError(t, err, "序列化不应出错")
+	assert.JSONEq(t, `{"nodes":["A","B","C"],"adj":[[1],[2],[]],"weights":[[0.5],[1],[]]}`,
+		string(data), "存在非默认权重时应输出weights")
+
+	decoded := ggraph.NewGraph[string]()
+	assert.NoError(t, json.Unmarshal(data, decoded), "反序列化不应出错")
+	weight, _ := decoded.EdgeWeight("A", "B")
+	assert.Equal(t, 0.5, weight, "边权重应保留")
+	assert.Error(t, json.Unmarshal([]byte(`{"nodes":["A","B"],"adj":[[1],[]],"weights":[[],[]]}`), decoded),
+		"权重表与邻接表形状不一致应返回错误")
+}
+
+func TestUnmarshalJSONInvalid(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddNode("keep")
+
+	assert.Error(t, json.Unmarshal([]byte(`{"nodes":["A"],"adj":[[3]]}`), graph), "邻居索引越界应返回错误")
+	assert.Error(t, json.Unmarshal([]byte(`{"nodes":["A","A"],"adj":[]}`), graph), "节点重复应返回错误")
+	assert.Error(t, json.Unmarshal([]byte(`{"nodes":[1],"adj":[]}`), graph), "节点类型不匹配应返回错误")
+	assert.True(t, graph.HasNode("keep"), "反序列化失败时不应修改原图")
+}
+
+func TestUnmarshalJSONUncomparableNodes(t *testing.T) {
+	data, err := json.Marshal(ggraph.GridGraph(2, 2))
+	assert.NoError(t, err, "序列化不应出错")
+
+	graph := ggraph.NewGraph[any]()
+	graph.AddNode("keep")
+	assert.NotPanics(t, func() {
+		err = json.Unmarshal(data, graph)
+	}, "数组节点不应导致panic")
+	assert.Error(t, err, "Graph[any]包含数组节点时应返回错误")
+	assert.Error(t, json.Unmarshal([]byte(`{"nodes":[{"id":1}],"adj":[[]]}`), graph), "Graph[any]包含对象节点时应返回错误")
+	assert.True(t, graph.HasNode("keep"), "反序列化失败时不应修改原图")
+
+	grid := ggraph.NewUndirectedGraph[[2]int]()
+	assert.NoError(t, json.Unmarshal(data, grid), "具体数组类型的图应可反序列化")
+	assert.True(t, ggraph.GridGraph(2, 2).Equal(grid), "往返后图应相等")
+
+	nullable := ggraph.NewGraph[any]()
+	assert.NoError(t, json.Unmarshal([]byte(`{"nodes":[null],"adj":[[]]}`), nullable), "null节点可以作为映射键")
+	assert.True(t, nullable.HasNode(nil), "应包含nil节点")
+}
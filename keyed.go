@@ -0,0 +1,81 @@
+package ggraph
+
+// KeyedGraph 以key函数提取的键确定节点身份的图包装，适用于需要自定义相等语义的节点类型
+// 底层以键构建Graph[K]，同时保存每个键首次添加时的原始值，查询结果返回原始值
+type KeyedGraph[T any, K comparable] struct {
+	key    func(T) K
+	graph  *Graph[K]
+	values map[K]T
+}
+
+// NewGraphWithKey 初始化一个空的KeyedGraph，key返回相同键的值被视为同一个节点
+// 例如key将字段转为小写时，只有大小写不同的值会合并为一个节点
+func NewGraphWithKey[T any, K comparable](key func(T) K) *KeyedGraph[T, K] {
+	return &KeyedGraph[T, K]{
+		key:    key,
+		graph:  NewGraph[K](),
+		values: make(map[K]T),
+	}
+}
+
+// AddNode 添加一个节点，键已存在时不做任何操作（保留首次添加的原始值）
+func (kg *KeyedGraph[T, K]) AddNode(node T) {
+	k := kg.key(node)
+	if kg.graph.HasNode(k) {
+		return
+	}
+	kg.values[k] = node
+	kg.graph.AddNode(k)
+}
+
+// AddEdge 添加一条从from到to的有向边（自动添加缺失节点）
+func (kg *KeyedGraph[T, K]) AddEdge(from, to T) {
+	kg.AddNode(from)
+	kg.AddNode(to)
+	kg.graph.AddEdge(kg.key(from), kg.key(to))
+}
+
+// HasNode 检查图中是否存在与node键相同的节点
+func (kg *KeyedGraph[T, K]) HasNode(node T) bool {
+	return kg.graph.HasNode(kg.key(node))
+}
+
+// HasEdge 检查是否存在从from到to（按键比较）的有向边
+func (kg *KeyedGraph[T, K]) HasEdge(from, to T) bool {
+	return kg.graph.HasEdge(kg.key(from), kg.key(to))
+}
+
+// Neighbors 返回与node键相同的节点的所有邻居（原始值），节点不存在时返回空列表
+func (kg *KeyedGraph[T, K]) Neighbors(node T) []T {
+	return kg.toValues(kg.graph.Neighbors(kg.key(node)))
+}
+
+// Nodes 按插入顺序返回所有节点的原始值
+func (kg *KeyedGraph[T, K]) Nodes() []T {
+	return kg.toValues(kg.graph.Nodes())
+}
+
+// NodeCount 返回图中节点（不同键）的数量
+func (kg *KeyedGraph[T, K]) NodeCount() int {
+	return kg.graph.NodeCount()
+}
+
+// Graph 返回以键为节点的底层图，可用于调用其余算法；直接修改底层图会使原始值表失去同步
+func (kg *KeyedGraph[T, K]) Graph() *Graph[K] {
+	return kg.graph
+}
+
+// Value 返回键k对应节点的原始值及节点是否存在
+func (kg *KeyedGraph[T, K]) Value(k K) (T, bool) {
+	value, exists := kg.values[k]
+	return value, exists
+}
+
+// toValues 将键序列映射为原始值序列
+func (kg *KeyedGraph[T, K]) toValues(keys []K) []T {
+	values := make([]T, len(keys))
+	for i, k := range keys {
+		values[i] = kg.values[k]
+	}
+	return values
+}
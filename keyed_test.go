@@ -0,0 +1,36 @@
+package ggraph_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nosusume/ggraph"
+	"github.com/stretchr/testify/assert"
+)
+
+type user struct {
+	Name string
+	Tags []string // 切片字段使user不可比较
+}
+
+func TestKeyedGraph(t *testing.T) {
+	graph := ggraph.NewGraphWithKey(func(u user) string {
+		return strings.ToLower(u.Name)
+	})
+	alice := user{Name: "Alice", Tags: []string{"admin"}}
+	graph.AddNode(alice)
+	graph.AddNode(user{Name: "ALICE"})
+	graph.AddEdge(user{Name: "alice"}, user{Name: "Bob"})
+
+	assert.Equal(t, 2, graph.NodeCount(), "只有大小写不同的值应合并为一个节点")
+	assert.True(t, graph.HasNode(user{Name: "aLiCe"}), "应按键判断节点是否存在")
+	assert.True(t, graph.HasEdge(user{Name: "ALICE"}, user{Name: "bob"}), "应按键判断边是否存在")
+	assert.Equal(t, []user{alice, {Name: "Bob"}}, graph.Nodes(), "应返回首次添加的原始值")
+	assert.Equal(t, []user{{Name: "Bob"}}, graph.Neighbors(user{Name: "alice"}), "邻居应返回原始值")
+	assert.Empty(t, graph.Neighbors(user{Name: "carol"}), "节点不存在时应返回空列表")
+
+	value, ok := graph.Value("alice")
+	assert.True(t, ok, "应可按键取得原始值")
+	assert.Equal(t, alice, value, "应返回首次添加的原始值")
+	assert.Equal(t, []string{"alice", "bob"}, graph.Graph().Nodes(), "底层图应以键为节点")
+}
@@ -0,0 +1,64 @@
+package ggraph
+
+import "fmt"
+
+// MaximumBipartiteMatching 使用增广路径算法（Kuhn算法，BFS寻找增广路径）计算二分图的最大匹配
+// 有出边的节点属于左部，有入边的节点属于右部，所有边都应从左部指向右部；
+// 某个节点同时有出边和入边（包括自环）时返回ErrNotBipartite，因此无向图模式下的非空图总是返回错误
+// 返回的匹配边按左部节点的索引顺序排列；平行边视为同一条边，孤立节点被忽略
+func (g *Graph[T]) MaximumBipartiteMatching() ([]Edge[T], error) {
+	inDegree := g.inDegrees()
+	for idx, neighbors := range g.adj {
+		if len(neighbors) > 0 && inDegree[idx] > 0 {
+			return nil, fmt.Errorf("%w: node %v has both incoming and outgoing edges", ErrNotBipartite, g.indexToNode[idx])
+		}
+	}
+
+	n := len(g.nodes)
+	matchLeft := make([]int, n)  // 左部节点匹配的右部节点
+	matchRight := make([]int, n) // 右部节点匹配的左部节点
+	for i := range matchLeft {
+		matchLeft[i], matchRight[i] = -1, -1
+	}
+	parent := make([]int, n) // 右部节点在交错树中的左部父节点，-1表示未访问
+	for start, neighbors := range g.adj {
+		if len(neighbors) == 0 {
+			continue
+		}
+		for i := range parent {
+			parent[i] = -1
+		}
+		queue := []int{start}
+		augmented := false
+		for len(queue) > 0 && !augmented {
+			left := queue[0]
+			queue = queue[1:]
+			for _, right := range g.adj[left] {
+				if parent[right] != -1 {
+					continue
+				}
+				parent[right] = left
+				if matchRight[right] == -1 {
+					// 沿交错路径回溯，翻转匹配
+					for right != -1 {
+						owner := parent[right]
+						next := matchLeft[owner]
+						matchLeft[owner], matchRight[right] = right, owner
+						right = next
+					}
+					augmented = true
+					break
+				}
+				queue = append(queue, matchRight[right])
+			}
+		}
+	}
+
+	matching := make([]Edge[T], 0)
+	for left, right := range matchLeft {
+		if right != -1 {
+			matching = append(matching, Edge[T]{From: g.indexToNode[left], To: g.indexToNode[right]})
+		}
+	}
+	return matching, nil
+}
@@ -0,0 +1,45 @@
+package ggraph_test
+
+import (
+	"testing"
+
+	"github.com/nosusume/ggraph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaximumBipartiteMatching(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	// 贪心地让w1匹配j1会使w2无法匹配，需要增广路径调整
+	graph.AddEdge("w1", "j1")
+	graph.AddEdge("w1", "j2")
+	graph.AddEdge("w2", "j1")
+	graph.AddEdge("w3", "j2")
+	graph.AddEdge("w3", "j3")
+	graph.AddEdge("w4", "j3")
+	graph.AddEdge("w2", "j1") // 平行边
+
+	matching, err := graph.MaximumBipartiteMatching()
+	assert.NoError(t, err, "二分图不应返回错误")
+	assert.Len(t, matching, 3, "最大匹配的大小应为3（只有3个右部节点）")
+	matchedLeft, matchedRight := make(map[string]bool), make(map[string]bool)
+	for _, edge := range matching {
+		assert.True(t, graph.HasEdge(edge.From, edge.To), "匹配边%v->%v应存在于图中", edge.From, edge.To)
+		assert.False(t, matchedLeft[edge.From], "左部节点%v不应被重复匹配", edge.From)
+		assert.False(t, matchedRight[edge.To], "右部节点%v不应被重复匹配", edge.To)
+		matchedLeft[edge.From], matchedRight[edge.To] = true, true
+	}
+	assert.True(t, matchedLeft["w2"], "w2只能匹配j1，最大匹配中应包含w2")
+
+	empty, err := ggraph.NewGraph[int]().MaximumBipartiteMatching()
+	assert.NoError(t, err, "空图不应返回错误")
+	assert.Empty(t, empty, "空图的匹配应为空")
+}
+
+func TestMaximumBipartiteMatchingNotBipartite(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", "C")
+
+	_, err := graph.MaximumBipartiteMatching()
+	assert.ErrorIs(t, err, ggraph.ErrNotBipartite, "节点同时有入边和出边时应返回ErrNotBipartite")
+}
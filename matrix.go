@@ -0,0 +1,17 @@
+package ggraph
+
+import "slices"
+
+// AdjacencyMatrix 返回图的N×N邻接矩阵及矩阵行列对应的节点顺序（即索引顺序）
+// matrix[i][j]为从nodes[i]到nodes[j]的边数，平行边按重数计，因此元素可能大于1
+func (g *Graph[T]) AdjacencyMatrix() ([][]int, []T) {
+	n := len(g.nodes)
+	matrix := make([][]int, n)
+	for from := range matrix {
+		matrix[from] = make([]int, n)
+		for _, to := range g.adj[from] {
+			matrix[from][to]++
+		}
+	}
+	return matrix, slices.Clone(g.indexToNode)
+}
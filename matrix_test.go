@@ -0,0 +1,31 @@
+package ggraph_test
+
+import (
+	"testing"
+
+	"github.com/nosusume/ggraph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdjacencyMatrix(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("A", "B") // 平行边
+	graph.AddEdge("B", "C")
+	graph.AddEdge("C", "C")
+	graph.AddNode("D")
+
+	matrix, nodes := graph.AdjacencyMatrix()
+	assert.Equal(t, []string{"A", "B", "C", "D"}, nodes, "节点顺序应为索引顺序")
+	assert.Equal(t, [][]int{
+		{0, 2, 0, 0},
+		{0, 0, 1, 0},
+		{0, 0, 1, 0},
+		{0, 0, 0, 0},
+	}, matrix, "平行边应按重数计")
+	for i, from := range nodes {
+		for j, to := range nodes {
+			assert.Equal(t, graph.HasEdge(from, to), matrix[i][j] > 0, "矩阵应与HasEdge(%s, %s)一致", from, to)
+		}
+	}
+}
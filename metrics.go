@@ -0,0 +1,137 @@
+package ggraph
+
+import "slices"
+
+// Diameter 返回图的直径，即所有可达有序节点对之间最短路径跳数的最大值（沿有向边）
+// 不可达的有序节点对不参与计算，例如有向链0->1->2->3->4的直径为4；
+// 图为空或弱不连通（视为无向图时不连通）时不存在有限直径，返回(0, false)
+// 对每个节点执行一次BFS，时间复杂度O(V·(V+E))
+func (g *Graph[T]) Diameter() (int, bool) {
+	if len(g.nodes) == 0 || g.ComponentCount() > 1 {
+		return 0, false
+	}
+	diameter := 0
+	for start := range g.adj {
+		for _, d := range g.bfsDist(start) {
+			diameter = max(diameter, d)
+		}
+	}
+	return diameter, true
+}
+
+// Eccentricity 返回节点的离心率，即从node沿有向边到其他各节点最短路径跳数的最大值
+// 存在从node不可达的节点时离心率为无穷大，返回(0, false)；node不存在时同样返回(0, false)
+func (g *Graph[T]) Eccentricity(node T) (int, bool) {
+	index, exists := g.nodes[node]
+	if !exists {
+		return 0, false
+	}
+	return eccentricity(g.bfsDist(index))
+}
+
+// Center 按索引顺序返回离心率最小的所有节点（图的中心）
+// 离心率为无穷大的节点不参与比较；所有节点的离心率均为无穷大或图为空时返回空切片
+// 对每个节点执行一次BFS，时间复杂度O(V·(V+E))
+func (g *Graph[T]) Center() []T {
+	center := make([]T, 0)
+	best := -1
+	for idx, node := range g.indexToNode {
+		e, ok := eccentricity(g.bfsDist(idx))
+		if !ok || (best >= 0 && e > best) {
+			continue
+		}
+		if e < best || best < 0 {
+			best = e
+			center = center[:0]
+		}
+		center = append(center, node)
+	}
+	return center
+}
+
+// eccentricity 根据BFS距离表返回最大距离，存在不可达节点时第二个返回值为false
+func eccentricity(dist []int) (int, bool) {
+	e := 0
+	for _, d := range dist {
+		if d < 0 {
+			return 0, false
+		}
+		e = max(e, d)
+	}
+	return e, true
+}
+
+// Density 返回图的密度，即存在边的不同有序节点对(u,v)（u≠v）数量与N·(N-1)之比
+// 平行边只计一次、自环不计入，因此结果始终位于[0,1]；节点数小于2时返回0
+func (g *Graph[T]) Density() float64 {
+	n := len(g.nodes)
+	if n < 2 {
+		return 0
+	}
+	pairs := 0
+	seen := make([]bool, n)
+	for from, neighbors := range g.adj {
+		for _, to := range neighbors {
+			if to != from && !seen[to] {
+				seen[to] = true
+				pairs++
+			}
+		}
+		for _, to := range neighbors {
+			seen[to] = false
+		}
+	}
+	return float64(pairs) / float64(n*(n-1))
+}
+
+// DegreeHistogram 返回度分布，键为度数（入度+出度），值为具有该度数的节点数量
+// 平行边按重数计；自环同时计入入度和出度，因此计两次；孤立节点计入度数0
+func (g *Graph[T]) DegreeHistogram() map[int]int {
+	histogram := make(map[int]int)
+	inDegree := g.inDegrees()
+	for idx := range g.indexToNode {
+		histogram[inDegree[idx]+len(g.adj[idx])]++
+	}
+	return histogram
+}
+
+// ClusteringCoefficient 返回节点的局部聚类系数，即其邻居两两之间相连的比例
+// 边视为无向边，忽略自环与平行边；邻居少于两个或节点不存在时返回0
+func (g *Graph[T]) ClusteringCoefficient(node T) float64 {
+	index, exists := g.nodes[node]
+	if !exists {
+		return 0
+	}
+	return clusteringCoefficient(g.simpleUndirectedAdj(), index)
+}
+
+// AverageClusteringCoefficient 返回所有节点局部聚类系数的平均值，空图返回0
+func (g *Graph[T]) AverageClusteringCoefficient() float64 {
+	if len(g.nodes) == 0 {
+		return 0
+	}
+	undirected := g.simpleUndirectedAdj()
+	total := 0.0
+	for index := range undirected {
+		total += clusteringCoefficient(undirected, index)
+	}
+	return total / float64(len(undirected))
+}
+
+// clusteringCoefficient 在简单无向邻接表上计算index的局部聚类系数
+func clusteringCoefficient(undirected [][]int, index int) float64 {
+	neighbors := undirected[index]
+	k := len(neighbors)
+	if k < 2 {
+		return 0
+	}
+	links := 0
+	for i, u := range neighbors {
+		for _, v := range neighbors[i+1:] {
+			if _, found := slices.BinarySearch(undirected[u], v); found {
+				links++
+			}
+		}
+	}
+	return float64(links) / float64(k*(k-1)/2)
+}
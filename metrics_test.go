@@ -0,0 +1,122 @@
+package ggraph_test
+
+import (
+	"testing"
+
+	"github.com/nosusume/ggraph"
+	"github.com/stretchr/testify/assert"
+)
+
+// newPathGraph 构建节点为0..n-1的有向链
+func newPathGraph(n int) *ggraph.Graph[int] {
+	graph := ggraph.NewGraph[int]()
+	graph.AddNode(0)
+	for i := 0; i < n-1; i++ {
+		graph.AddEdge(i, i+1)
+	}
+	return graph
+}
+
+func TestDiameter(t *testing.T) {
+	diameter, ok := newPathGraph(5).Diameter()
+	assert.True(t, ok, "连通图应存在直径")
+	assert.Equal(t, 4, diameter, "长度为4的链直径应为4")
+
+	diameter, ok = newPathGraph(1).Diameter()
+	assert.True(t, ok, "单节点图应存在直径")
+	assert.Equal(t, 0, diameter, "单节点图直径应为0")
+}
+
+func TestDiameterNoFinite(t *testing.T) {
+	_, ok := ggraph.NewGraph[int]().Diameter()
+	assert.False(t, ok, "空图不存在直径")
+
+	graph := newPathGraph(3)
+	graph.AddNode(9)
+	_, ok = graph.Diameter()
+	assert.False(t, ok, "不连通图不存在有限直径")
+}
+
+func TestDensity(t *testing.T) {
+	assert.Equal(t, 1.0, ggraph.CompleteGraph(6).Density(), "完全有向图密度应为1")
+	edgeless := ggraph.NewGraph[int]()
+	edgeless.AddNode(1)
+	edgeless.AddNode(2)
+	assert.Equal(t, 0.0, edgeless.Density(), "无边图密度应为0")
+	assert.Equal(t, 0.0, ggraph.CompleteGraph(1).Density(), "单节点图密度应为0")
+	assert.InDelta(t, 4.0/20, newPathGraph(5).Density(), 1e-12, "链的密度应为(N-1)/(N·(N-1))")
+
+	multi := ggraph.CompleteGraph(3)
+	multi.AddEdge(0, 1)
+	multi.AddEdge(1, 2)
+	multi.AddEdge(2, 2)
+	assert.Equal(t, 1.0, multi.Density(), "平行边和自环不应使密度超过1")
+
+	loops := newPathGraph(3)
+	loops.AddEdge(0, 0)
+	loops.AddEdge(0, 1)
+	assert.InDelta(t, 2.0/6, loops.Density(), 1e-12, "自环和平行边不计入密度")
+}
+
+func TestDegreeHistogram(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	for leaf := 1; leaf <= 5; leaf++ {
+		graph.AddEdge(0, leaf)
+	}
+	assert.Equal(t, map[int]int{1: 5, 5: 1}, graph.DegreeHistogram(), "星形图的叶子度数为1，中心度数为叶子数")
+
+	graph.AddEdge(6, 6)
+	graph.AddNode(7)
+	assert.Equal(t, map[int]int{0: 1, 1: 5, 2: 1, 5: 1}, graph.DegreeHistogram(), "自环应计两次，孤立节点度数为0")
+	assert.Empty(t, ggraph.NewGraph[int]().DegreeHistogram(), "空图应返回空分布")
+}
+
+func TestClusteringCoefficient(t *testing.T) {
+	triangle := ggraph.NewGraph[string]()
+	triangle.AddEdge("A", "B")
+	triangle.AddEdge("B", "C")
+	triangle.AddEdge("C", "A")
+	for _, node := range []string{"A", "B", "C"} {
+		assert.Equal(t, 1.0, triangle.ClusteringCoefficient(node), "三角形中每个节点的聚类系数应为1: %s", node)
+	}
+	assert.Equal(t, 1.0, triangle.AverageClusteringCoefficient(), "三角形的平均聚类系数应为1")
+
+	star := ggraph.NewGraph[int]()
+	for leaf := 1; leaf <= 4; leaf++ {
+		star.AddEdge(0, leaf)
+	}
+	assert.Zero(t, star.ClusteringCoefficient(0), "星形图中心的聚类系数应为0")
+	assert.Zero(t, star.ClusteringCoefficient(1), "邻居少于两个的节点应为0")
+	assert.Zero(t, star.AverageClusteringCoefficient(), "星形图的平均聚类系数应为0")
+	assert.Zero(t, star.ClusteringCoefficient(99), "节点不存在时应为0")
+
+	star.AddEdge(2, 1)
+	assert.InDelta(t, 1.0/6, star.ClusteringCoefficient(0), 1e-9, "4个邻居中有1对相连")
+}
+
+func TestEccentricityAndCenter(t *testing.T) {
+	graph := ggraph.NewUndirectedGraph[int]()
+	for i := 0; i < 4; i++ {
+		graph.AddEdge(i, i+1)
+	}
+
+	for node, expected := range []int{4, 3, 2, 3, 4} {
+		e, ok := graph.Eccentricity(node)
+		assert.True(t, ok, "连通图中离心率应有限: %d", node)
+		assert.Equal(t, expected, e, "节点%d的离心率应为%d", node, expected)
+	}
+	assert.Equal(t, []int{2}, graph.Center(), "路径图的中心应为中间节点")
+
+	graph.AddEdge(5, 6)
+	_, ok := graph.Eccentricity(0)
+	assert.False(t, ok, "存在不可达节点时离心率应为无穷大")
+	_, ok = graph.Eccentricity(9)
+	assert.False(t, ok, "节点不存在时应返回false")
+	assert.Empty(t, graph.Center(), "所有离心率均为无穷大时中心应为空")
+
+	directed := newPathGraph(4)
+	e, ok := directed.Eccentricity(0)
+	assert.True(t, ok, "有向链的起点可到达所有节点")
+	assert.Equal(t, 3, e, "有向链起点的离心率应为3")
+	assert.Equal(t, []int{0}, directed.Center(), "有向链中只有起点的离心率有限")
+}
@@ -0,0 +1,98 @@
+package ggraph
+
+import (
+	"container/heap"
+	"slices"
+)
+
+// weightedEdge 以节点索引表示的带权边
+type weightedEdge struct {
+	from, to int
+	weight   float64
+}
+
+// MinimumSpanningTree 将图视为无向图，使用Kruskal算法和并查集计算最小生成树
+// 返回树边（方向与图中存储的一致）及总权重；图不连通时返回ErrDisconnected，
+// 不返回生成森林；空图返回空树。自环被忽略，等权边按插入顺序优先选取
+func (g *Graph[T]) MinimumSpanningTree() ([]Edge[T], float64, error) {
+	edges := make([]weightedEdge, 0, g.EdgeCount())
+	for from, neighbors := range g.adj {
+		for i, to := range neighbors {
+			if from != to {
+				edges = append(edges, weightedEdge{from: from, to: to, weight: g.weights[from][i]})
+			}
+		}
+	}
+	slices.SortStableFunc(edges, func(a, b weightedEdge) int {
+		switch {
+		case a.weight < b.weight:
+			return -1
+		case a.weight > b.weight:
+			return 1
+		}
+		return 0
+	})
+
+	ds := newDisjointSet(len(g.nodes))
+	tree := make([]Edge[T], 0, max(len(g.nodes)-1, 0))
+	total := 0.0
+	for _, edge := range edges {
+		if ds.union(edge.from, edge.to) {
+			tree = append(tree, Edge[T]{From: g.indexToNode[edge.from], To: g.indexToNode[edge.to]})
+			total += edge.weight
+		}
+	}
+	if ds.count > 1 {
+		return nil, 0, ErrDisconnected
+	}
+	return tree, total, nil
+}
+
+// PrimMST 将图视为无向图，使用Prim算法从start开始生长最小生成树
+// 只覆盖start所在的连通分量；返回树边（方向与图中存储的一致）及总权重
+// start不存在时返回空树；自环被忽略
+func (g *Graph[T]) PrimMST(start T) ([]Edge[T], float64) {
+	tree := make([]Edge[T], 0)
+	startIndex, exists := g.nodes[start]
+	if !exists {
+		return tree, 0
+	}
+	// 收集边并建立每个节点的关联边列表
+	edges := make([]weightedEdge, 0, g.EdgeCount())
+	incident := make([][]int, len(g.nodes))
+	for from, neighbors := range g.adj {
+		for i, to := range neighbors {
+			if from == to {
+				continue
+			}
+			incident[from] = append(incident[from], len(edges))
+			incident[to] = append(incident[to], len(edges))
+			edges = append(edges, weightedEdge{from: from, to: to, weight: g.weights[from][i]})
+		}
+	}
+
+	inTree := make([]bool, len(g.nodes))
+	pq := &priorityQueue{}
+	grow := func(index int) {
+		inTree[index] = true
+		for _, id := range incident[index] {
+			heap.Push(pq, pqItem{index: id, priority: edges[id].weight})
+		}
+	}
+	grow(startIndex)
+	total := 0.0
+	for pq.Len() > 0 {
+		edge := edges[heap.Pop(pq).(pqItem).index]
+		next := edge.to
+		if inTree[next] {
+			next = edge.from
+		}
+		if inTree[next] {
+			continue
+		}
+		tree = append(tree, Edge[T]{From: g.indexToNode[edge.from], To: g.indexToNode[edge.to]})
+		total += edge.weight
+		grow(next)
+	}
+	return tree, total
+}
@@ -0,0 +1,76 @@
+package ggraph_test
+
+import (
+	"testing"
+
+	"github.com/nosusume/ggraph"
+	"github.com/stretchr/testify/assert"
+)
+
+// newMSTTestGraph 构建最小生成树总权重为39的连通带权图
+func newMSTTestGraph() *ggraph.Graph[string] {
+	graph := ggraph.NewGraph[string]()
+	graph.AddWeightedEdge("A", "B", 7)
+	graph.AddWeightedEdge("A", "D", 5)
+	graph.AddWeightedEdge("B", "C", 8)
+	graph.AddWeightedEdge("B", "D", 9)
+	graph.AddWeightedEdge("E", "B", 7)
+	graph.AddWeightedEdge("C", "E", 5)
+	graph.AddWeightedEdge("D", "E", 15)
+	graph.AddWeightedEdge("D", "F", 6)
+	graph.AddWeightedEdge("F", "E", 8)
+	graph.AddWeightedEdge("F", "G", 11)
+	graph.AddWeightedEdge("E", "G", 9)
+	return graph
+}
+
+func TestMinimumSpanningTree(t *testing.T) {
+	graph := newMSTTestGraph()
+
+	tree, total, err := graph.MinimumSpanningTree()
+	assert.NoError(t, err, "连通图不应返回错误")
+	assert.Equal(t, 39.0, total, "最小生成树总权重应为39")
+	assert.Len(t, tree, graph.NodeCount()-1, "树边数量应为N-1")
+	assert.ElementsMatch(t, []ggraph.Edge[string]{
+		{From: "A", To: "D"}, {From: "C", To: "E"}, {From: "D", To: "F"},
+		{From: "A", To: "B"}, {From: "E", To: "B"}, {From: "E", To: "G"},
+	}, tree, "应返回正确的树边")
+}
+
+func TestMinimumSpanningTreeDisconnected(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	graph.AddWeightedEdge(1, 2, 1)
+	graph.AddNode(3)
+
+	tree, _, err := graph.MinimumSpanningTree()
+	assert.ErrorIs(t, err, ggraph.ErrDisconnected, "不连通图应返回ErrDisconnected")
+	assert.Nil(t, tree, "不连通图不应返回树边")
+}
+
+func TestPrimMST(t *testing.T) {
+	graph := newMSTTestGraph()
+
+	_, kruskalTotal, err := graph.MinimumSpanningTree()
+	assert.NoError(t, err, "连通图不应返回错误")
+	for _, start := range graph.Nodes() {
+		tree, total := graph.PrimMST(start)
+		assert.Equal(t, kruskalTotal, total, "从%s开始的Prim与Kruskal总权重应一致", start)
+		assert.Len(t, tree, graph.NodeCount()-1, "树边数量应为N-1")
+	}
+}
+
+func TestPrimMSTComponent(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	graph.AddWeightedEdge(1, 2, 3)
+	graph.AddWeightedEdge(2, 3, 1)
+	graph.AddWeightedEdge(1, 3, 5)
+	graph.AddWeightedEdge(4, 5, 1)
+
+	tree, total := graph.PrimMST(3)
+	assert.Equal(t, 4.0, total, "应只覆盖start所在的连通分量")
+	assert.ElementsMatch(t, []ggraph.Edge[int]{{From: 2, To: 3}, {From: 1, To: 2}}, tree, "应返回正确的树边")
+
+	tree, total = graph.PrimMST(9)
+	assert.Empty(t, tree, "start不存在时应返回空树")
+	assert.Zero(t, total, "start不存在时总权重应为0")
+}
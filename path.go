@@ -0,0 +1,412 @@
+package ggraph
+
+import (
+	"container/heap"
+	"math"
+	"slices"
+)
+
+// ShortestPath 使用BFS查找从from到to的最少跳数路径（沿有向边）
+// 返回路径上的节点序列及路径是否存在；from与to相同且存在时返回仅含该节点的路径
+// 存在多条等长路径时，按边的插入顺序取最先发现的一条，结果是确定的
+func (g *Graph[T]) ShortestPath(from, to T) ([]T, bool) {
+	fromIndex, fromExists := g.nodes[from]
+	toIndex, toExists := g.nodes[to]
+	if !fromExists || !toExists {
+		return nil, false
+	}
+	prev := g.bfsPrev(fromIndex, toIndex)
+	if prev[toIndex] == -1 {
+		return nil, false
+	}
+	return g.buildPath(prev, toIndex), true
+}
+
+// Distances 返回从start出发（沿有向边）到每个可达节点的跳数距离，start自身距离为0
+// 不可达的节点不包含在结果中；start不存在时返回空映射
+func (g *Graph[T]) Distances(start T) map[T]int {
+	distances := make(map[T]int)
+	startIndex, exists := g.nodes[start]
+	if !exists {
+		return distances
+	}
+	for i, d := range g.bfsDist(startIndex) {
+		if d >= 0 {
+			distances[g.indexToNode[i]] = d
+		}
+	}
+	return distances
+}
+
+// BFSTree 从start执行BFS，返回每个可达节点的跳数距离及其在BFS树中的前驱
+// start的距离为0且不出现在prev中；不可达的节点在两个映射中均被省略；start不存在时返回两个空映射
+// 沿prev回溯得到的路径与ShortestPath的结果一致，可用于一次BFS后重建到多个终点的路径
+func (g *Graph[T]) BFSTree(start T) (dist map[T]int, prev map[T]T) {
+	dist, prev = make(map[T]int), make(map[T]T)
+	startIndex, exists := g.nodes[start]
+	if !exists {
+		return dist, prev
+	}
+	distances := g.bfsDist(startIndex)
+	for i, p := range g.bfsPrev(startIndex, -1) {
+		if p == -1 {
+			continue
+		}
+		dist[g.indexToNode[i]] = distances[i]
+		if i != startIndex {
+			prev[g.indexToNode[i]] = g.indexToNode[p]
+		}
+	}
+	return dist, prev
+}
+
+// bfsPrev 从start索引执行BFS，返回前驱索引表
+// start的前驱为自身，未到达的节点前驱为-1；target>=0时到达target即提前结束
+func (g *Graph[T]) bfsPrev(start, target int) []int {
+	prev := make([]int, len(g.nodes))
+	for i := range prev {
+		prev[i] = -1
+	}
+	prev[start] = start
+	queue := []int{start}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if current == target {
+			break
+		}
+		for _, next := range g.adj[current] {
+			if prev[next] == -1 {
+				prev[next] = current
+				queue = append(queue, next)
+			}
+		}
+	}
+	return prev
+}
+
+// buildPath 根据前驱索引表回溯出以end结尾的节点路径
+// 起点由前驱为自身的节点标识
+func (g *Graph[T]) buildPath(prev []int, end int) []T {
+	return g.toNodes(indexPath(prev, end))
+}
+
+// indexPath 根据前驱索引表回溯出以end结尾的索引路径
+func indexPath(prev []int, end int) []int {
+	path := []int{end}
+	for current := end; prev[current] != current; {
+		current = prev[current]
+		path = append(path, current)
+	}
+	slices.Reverse(path)
+	return path
+}
+
+// toNodes 将索引序列映射为节点序列
+func (g *Graph[T]) toNodes(indices []int) []T {
+	nodes := make([]T, len(indices))
+	for i, idx := range indices {
+		nodes[i] = g.indexToNode[idx]
+	}
+	return nodes
+}
+
+// DijkstraPath 使用Dijkstra算法查找从from到to的最小权重路径
+// 返回路径、路径总权重及路径是否存在；from与to相同且存在时返回仅含该节点、权重为0的路径
+// 该算法要求边权非负，负权边在搜索中被忽略
+func (g *Graph[T]) DijkstraPath(from, to T) ([]T, float64, bool) {
+	fromIndex, fromExists := g.nodes[from]
+	toIndex, toExists := g.nodes[to]
+	if !fromExists || !toExists {
+		return nil, 0, false
+	}
+	dist, prev := g.dijkstra(fromIndex, toIndex)
+	if prev[toIndex] == -1 {
+		return nil, 0, false
+	}
+	return g.buildPath(prev, toIndex), dist[toIndex], true
+}
+
+// AStar 使用A*算法在带权图上查找从from到to的最小权重路径
+// heuristic返回节点到to的估计代价，应为可采纳的（不高估实际代价），否则结果可能不是最优路径；
+// heuristic可采纳但不一致（不满足单调性）时，已关闭的节点在发现更短距离后会被重新打开，结果仍然最优
+// heuristic恒为0时与DijkstraPath的结果完全一致；与DijkstraPath相同，负权边被忽略
+func (g *Graph[T]) AStar(from, to T, heuristic func(T) float64) ([]T, float64, bool) {
+	fromIndex, fromExists := g.nodes[from]
+	toIndex, toExists := g.nodes[to]
+	if !fromExists || !toExists {
+		return nil, 0, false
+	}
+	dist, prev := g.bestFirst(fromIndex, toIndex, func(index int) float64 {
+		return heuristic(g.indexToNode[index])
+	}, nil)
+	if prev[toIndex] == -1 {
+		return nil, 0, false
+	}
+	return g.buildPath(prev, toIndex), dist[toIndex], true
+}
+
+// dijkstra 从start索引执行Dijkstra算法，返回距离表和前驱索引表
+// start的前驱为自身，未到达的节点距离为+Inf、前驱为-1；target>=0时确定target距离后提前结束
+func (g *Graph[T]) dijkstra(start, target int) ([]float64, []int) {
+	return g.bestFirst(start, target, nil, nil)
+}
+
+// bestFirst 基于优先队列的最佳优先搜索，优先级为已知距离加heuristic估计值
+// heuristic为nil时即Dijkstra算法；heuristic非nil时，已关闭的节点在发现更短距离后重新打开，
+// 以保证可采纳但不一致的heuristic也能得到最优路径；blocked非nil时跳过其返回true的边；返回值含义同dijkstra
+func (g *Graph[T]) bestFirst(start, target int, heuristic func(index int) float64, blocked func(from, to int) bool) ([]float64, []int) {
+	dist := make([]float64, len(g.nodes))
+	prev := make([]int, len(g.nodes))
+	for i := range dist {
+		dist[i] = math.Inf(1)
+		prev[i] = -1
+	}
+	closed := make([]bool, len(g.nodes))
+	dist[start] = 0
+	prev[start] = start
+	pq := &priorityQueue{{index: start}}
+	for pq.Len() > 0 {
+		current := heap.Pop(pq).(pqItem).index
+		if closed[current] {
+			// 过期条目
+			continue
+		}
+		closed[current] = true
+		if current == target {
+			break
+		}
+		for i, next := range g.adj[current] {
+			weight := g.weights[current][i]
+			if weight < 0 || (closed[next] && heuristic == nil) || (blocked != nil && blocked(current, next)) {
+				continue
+			}
+			if alt := dist[current] + weight; alt < dist[next] {
+				dist[next] = alt
+				prev[next] = current
+				closed[next] = false
+				priority := alt
+				if heuristic != nil {
+					priority += heuristic(next)
+				}
+				heap.Push(pq, pqItem{index: next, priority: priority})
+			}
+		}
+	}
+	return dist, prev
+}
+
+// pqItem 优先队列条目，记录节点索引及其优先级
+type pqItem struct {
+	index    int
+	priority float64
+}
+
+// priorityQueue 基于container/heap的最小优先队列
+type priorityQueue []pqItem
+
+func (pq priorityQueue) Len() int           { return len(pq) }
+func (pq priorityQueue) Less(i, j int) bool { return pq[i].priority < pq[j].priority }
+func (pq priorityQueue) Swap(i, j int)      { pq[i], pq[j] = pq[j], pq[i] }
+func (pq *priorityQueue) Push(x any)        { *pq = append(*pq, x.(pqItem)) }
+func (pq *priorityQueue) Pop() any {
+	old := *pq
+	item := old[len(old)-1]
+	*pq = old[:len(old)-1]
+	return item
+}
+
+// FloydWarshall 使用Floyd-Warshall算法计算所有节点对之间的最小权重距离，时间复杂度O(N³)
+// 结果包含所有节点对：节点到自身的距离为0，不可达的节点对距离为+Inf；平行边取权重最小的一条
+// 与DijkstraPath不同，负权边参与计算；存在负权环时返回ErrNegativeCycle
+func (g *Graph[T]) FloydWarshall() (map[T]map[T]float64, error) {
+	n := len(g.nodes)
+	dist := make([][]float64, n)
+	for i := range dist {
+		dist[i] = make([]float64, n)
+		for j := range dist[i] {
+			if i != j {
+				dist[i][j] = math.Inf(1)
+			}
+		}
+		for k, to := range g.adj[i] {
+			dist[i][to] = min(dist[i][to], g.weights[i][k])
+		}
+	}
+	for k := 0; k < n; k++ {
+		for i := 0; i < n; i++ {
+			if math.IsInf(dist[i][k], 1) {
+				continue
+			}
+			for j := 0; j < n; j++ {
+				if alt := dist[i][k] + dist[k][j]; alt < dist[i][j] {
+					dist[i][j] = alt
+				}
+			}
+		}
+	}
+	for i := 0; i < n; i++ {
+		if dist[i][i] < 0 {
+			return nil, ErrNegativeCycle
+		}
+	}
+
+	result := make(map[T]map[T]float64, n)
+	for i, from := range g.indexToNode {
+		row := make(map[T]float64, n)
+		for j, to := range g.indexToNode {
+			row[to] = dist[i][j]
+		}
+		result[from] = row
+	}
+	return result, nil
+}
+
+// AllPaths 返回从from到to的所有简单有向路径（路径中节点不重复）
+// from与to相同且存在时返回仅含一条零长度路径[from]的结果；端点不存在时返回空结果
+// 平行边不会产生重复路径；路径数量可能随图规模指数增长，必要时请使用AllPathsBounded
+func (g *Graph[T]) AllPaths(from, to T) [][]T {
+	return g.AllPathsBounded(from, to, 0, 0)
+}
+
+// AllPathsBounded 同AllPaths，但只返回边数不超过maxLength的路径，且至多返回maxCount条
+// maxLength或maxCount小于等于0表示不限制；路径按DFS顺序（边的插入顺序）发现
+func (g *Graph[T]) AllPathsBounded(from, to T, maxLength, maxCount int) [][]T {
+	paths := make([][]T, 0)
+	fromIndex, fromExists := g.nodes[from]
+	toIndex, toExists := g.nodes[to]
+	if !fromExists || !toExists {
+		return paths
+	}
+	if fromIndex == toIndex {
+		return append(paths, []T{from})
+	}
+	onPath := make([]bool, len(g.nodes))
+	onPath[fromIndex] = true
+	stack := []dfsFrame{{index: fromIndex}}
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		neighbors := g.adj[top.index]
+		if top.next == len(neighbors) || (maxLength > 0 && len(stack) > maxLength) {
+			onPath[top.index] = false
+			stack = stack[:len(stack)-1]
+			continue
+		}
+		pos := top.next
+		next := neighbors[pos]
+		top.next++
+		if onPath[next] || slices.Contains(neighbors[:pos], next) {
+			continue
+		}
+		if next == toIndex {
+			path := make([]T, 0, len(stack)+1)
+			for _, frame := range stack {
+				path = append(path, g.indexToNode[frame.index])
+			}
+			paths = append(paths, append(path, to))
+			if maxCount > 0 && len(paths) >= maxCount {
+				return paths
+			}
+			continue
+		}
+		onPath[next] = true
+		stack = append(stack, dfsFrame{index: next})
+	}
+	return paths
+}
+
+// KShortestPaths 使用Yen算法返回从from到to的至多k条无环路径（节点不重复），按总权重递增排列
+// 第二个返回值为各路径对应的总权重；可用路径少于k条时返回全部可用路径
+// 路径按节点序列区分，平行边取权重最小的一条；与DijkstraPath相同，负权边被忽略
+func (g *Graph[T]) KShortestPaths(from, to T, k int) ([][]T, []float64) {
+	paths, costs := make([][]T, 0), make([]float64, 0)
+	fromIndex, fromExists := g.nodes[from]
+	toIndex, toExists := g.nodes[to]
+	if !fromExists || !toExists || k <= 0 {
+		return paths, costs
+	}
+	dist, prev := g.dijkstra(fromIndex, toIndex)
+	if prev[toIndex] == -1 {
+		return paths, costs
+	}
+	found := [][]int{indexPath(prev, toIndex)}
+	costs = append(costs, dist[toIndex])
+	type candidate struct {
+		path []int
+		cost float64
+	}
+	var candidates []candidate
+	known := func(path []int) bool {
+		for _, p := range found {
+			if slices.Equal(p, path) {
+				return true
+			}
+		}
+		for _, c := range candidates {
+			if slices.Equal(c.path, path) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for len(found) < k {
+		last := found[len(found)-1]
+		for i := 0; i < len(last)-1; i++ {
+			spur, root := last[i], last[:i+1]
+			// 屏蔽与root共享前缀的已知路径的下一条边，以及root中除spur外的节点
+			blockedEdges := make(map[[2]int]bool)
+			for _, p := range found {
+				if len(p) > i+1 && slices.Equal(p[:i+1], root) {
+					blockedEdges[[2]int{p[i], p[i+1]}] = true
+				}
+			}
+			blockedNodes := make([]bool, len(g.nodes))
+			for _, idx := range root[:i] {
+				blockedNodes[idx] = true
+			}
+			spurDist, spurPrev := g.bestFirst(spur, toIndex, nil, func(from, to int) bool {
+				return blockedNodes[to] || blockedEdges[[2]int{from, to}]
+			})
+			if spurPrev[toIndex] == -1 {
+				continue
+			}
+			path := append(slices.Clone(root[:i]), indexPath(spurPrev, toIndex)...)
+			if known(path) {
+				continue
+			}
+			candidates = append(candidates, candidate{path: path, cost: g.pathCost(root) + spurDist[toIndex]})
+		}
+		if len(candidates) == 0 {
+			break
+		}
+		best := 0
+		for i, c := range candidates {
+			if c.cost < candidates[best].cost {
+				best = i
+			}
+		}
+		found = append(found, candidates[best].path)
+		costs = append(costs, candidates[best].cost)
+		candidates = slices.Delete(candidates, best, best+1)
+	}
+	for _, path := range found {
+		paths = append(paths, g.toNodes(path))
+	}
+	return paths, costs
+}
+
+// pathCost 返回索引路径的总权重，相邻节点间取权重最小的非负边
+func (g *Graph[T]) pathCost(path []int) float64 {
+	total := 0.0
+	for i := 0; i+1 < len(path); i++ {
+		best := math.Inf(1)
+		for j, to := range g.adj[path[i]] {
+			if weight := g.weights[path[i]][j]; to == path[i+1] && weight >= 0 {
+				best = min(best, weight)
+			}
+		}
+		total += best
+	}
+	return total
+}
@@ -0,0 +1,320 @@
+package ggraph_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/nosusume/ggraph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShortestPath(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", "C")
+	graph.AddEdge("C", "D")
+	graph.AddEdge("A", "D")
+
+	path, ok := graph.ShortestPath("A", "D")
+	assert.True(t, ok, "A到D应存在路径")
+	assert.Equal(t, []string{"A", "D"}, path, "应返回最少跳数路径")
+
+	path, ok = graph.ShortestPath("D", "A")
+	assert.False(t, ok, "D到A不应存在路径")
+	assert.Nil(t, path, "路径不存在时应返回nil")
+}
+
+func TestShortestPathEqualLength(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("S", "X")
+	graph.AddEdge("S", "Y")
+	graph.AddEdge("Y", "T")
+	graph.AddEdge("X", "T")
+
+	for i := 0; i < 10; i++ {
+		path, ok := graph.ShortestPath("S", "T")
+		assert.True(t, ok, "S到T应存在路径")
+		assert.Equal(t, []string{"S", "X", "T"}, path, "等长路径应按边插入顺序确定选择")
+	}
+}
+
+func TestShortestPathSameNode(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	graph.AddNode(1)
+
+	path, ok := graph.ShortestPath(1, 1)
+	assert.True(t, ok, "起点与终点相同时应存在路径")
+	assert.Equal(t, []int{1}, path, "应返回仅含自身的路径")
+}
+
+func TestShortestPathMissingNode(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	graph.AddEdge(1, 2)
+
+	path, ok := graph.ShortestPath(1, 3)
+	assert.False(t, ok, "终点不存在时应返回false")
+	assert.Nil(t, path, "终点不存在时应返回nil")
+	path, ok = graph.ShortestPath(3, 1)
+	assert.False(t, ok, "起点不存在时应返回false")
+	assert.Nil(t, path, "起点不存在时应返回nil")
+}
+
+func TestDistances(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("A", "C")
+	graph.AddEdge("B", "D")
+	graph.AddEdge("C", "D")
+	graph.AddEdge("D", "E")
+	graph.AddEdge("C", "F")
+	graph.AddEdge("G", "A") // G不可从A到达
+
+	expected := map[string]int{"A": 0, "B": 1, "C": 1, "D": 2, "E": 3, "F": 2}
+	assert.Equal(t, expected, graph.Distances("A"), "应返回每个可达节点的跳数距离")
+	assert.Empty(t, graph.Distances("X"), "起点不存在时应返回空映射")
+}
+
+func TestBFSTree(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("A", "C")
+	graph.AddEdge("B", "D")
+	graph.AddEdge("C", "D")
+	graph.AddEdge("D", "E")
+	graph.AddEdge("G", "A")
+
+	dist, prev := graph.BFSTree("A")
+	assert.Equal(t, graph.Distances("A"), dist, "距离表应与Distances一致")
+	assert.NotContains(t, prev, "A", "起点不应有前驱")
+	assert.NotContains(t, dist, "G", "不可达节点应被省略")
+	assert.NotContains(t, prev, "G", "不可达节点应被省略")
+	for target := range dist {
+		path := []string{target}
+		for node := target; node != "A"; {
+			node = prev[node]
+			path = append([]string{node}, path...)
+		}
+		expected, _ := graph.ShortestPath("A", target)
+		assert.Equal(t, expected, path, "沿prev重建的路径应与ShortestPath一致: %s", target)
+		assert.Equal(t, dist[target], len(path)-1, "路径长度应等于距离: %s", target)
+	}
+
+	dist, prev = graph.BFSTree("X")
+	assert.Empty(t, dist, "起点不存在时应返回空映射")
+	assert.Empty(t, prev, "起点不存在时应返回空映射")
+}
+
+// newWeightedTestGraph 构建一个最少跳数路径并非最小权重路径的带权图
+func newWeightedTestGraph() *ggraph.Graph[string] {
+	graph := ggraph.NewGraph[string]()
+	graph.AddWeightedEdge("A", "B", 4)
+	graph.AddWeightedEdge("A", "C", 2)
+	graph.AddWeightedEdge("B", "C", 5)
+	graph.AddWeightedEdge("B", "D", 10)
+	graph.AddWeightedEdge("C", "E", 3)
+	graph.AddWeightedEdge("E", "D", 4)
+	graph.AddWeightedEdge("D", "F", 11)
+	graph.AddWeightedEdge("A", "F", 30)
+	return graph
+}
+
+func TestDijkstraPath(t *testing.T) {
+	graph := newWeightedTestGraph()
+
+	path, cost, ok := graph.DijkstraPath("A", "D")
+	assert.True(t, ok, "A到D应存在路径")
+	assert.Equal(t, []string{"A", "C", "E", "D"}, path, "应返回最小权重路径而非最少跳数路径")
+	assert.Equal(t, 9.0, cost, "最小权重应为9")
+
+	path, cost, ok = graph.DijkstraPath("A", "F")
+	assert.True(t, ok, "A到F应存在路径")
+	assert.Equal(t, []string{"A", "C", "E", "D", "F"}, path, "直连边更贵时应绕行")
+	assert.Equal(t, 20.0, cost, "最小权重应为20")
+
+	_, _, ok = graph.DijkstraPath("F", "A")
+	assert.False(t, ok, "F到A不应存在路径")
+}
+
+func TestDijkstraPathSameNode(t *testing.T) {
+	graph := newWeightedTestGraph()
+
+	path, cost, ok := graph.DijkstraPath("A", "A")
+	assert.True(t, ok, "起点与终点相同时应存在路径")
+	assert.Equal(t, []string{"A"}, path, "应返回仅含自身的路径")
+	assert.Zero(t, cost, "权重应为0")
+}
+
+func TestDijkstraPathIgnoresNegativeWeights(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddWeightedEdge("A", "B", 1)
+	graph.AddWeightedEdge("A", "C", -5)
+	graph.AddWeightedEdge("C", "B", 1)
+
+	path, cost, ok := graph.DijkstraPath("A", "B")
+	assert.True(t, ok, "A到B应存在路径")
+	assert.Equal(t, []string{"A", "B"}, path, "负权边应被忽略")
+	assert.Equal(t, 1.0, cost, "权重应为1")
+}
+
+func TestAStarZeroHeuristicMatchesDijkstra(t *testing.T) {
+	graph := newWeightedTestGraph()
+	zero := func(string) float64 { return 0 }
+
+	for _, from := range graph.Nodes() {
+		for _, to := range graph.Nodes() {
+			dPath, dCost, dOK := graph.DijkstraPath(from, to)
+			aPath, aCost, aOK := graph.AStar(from, to, zero)
+			assert.Equal(t, dOK, aOK, "%s->%s的可达性应一致", from, to)
+			assert.Equal(t, dPath, aPath, "%s->%s的路径应一致", from, to)
+			assert.Equal(t, dCost, aCost, "%s->%s的权重应一致", from, to)
+		}
+	}
+}
+
+func TestAStarInconsistentHeuristic(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddWeightedEdge("S", "A", 1)
+	graph.AddWeightedEdge("A", "C", 1)
+	graph.AddWeightedEdge("S", "C", 3)
+	graph.AddWeightedEdge("C", "G", 3)
+	// h(A)=4可采纳（A到G的实际代价为4）但不一致，C会先经S->C被关闭
+	heuristic := func(node string) float64 {
+		if node == "A" {
+			return 4
+		}
+		return 0
+	}
+
+	path, cost, ok := graph.AStar("S", "G", heuristic)
+	assert.True(t, ok, "应找到路径")
+	expectedPath, expectedCost, _ := graph.DijkstraPath("S", "G")
+	assert.Equal(t, []string{"S", "A", "C", "G"}, expectedPath, "Dijkstra应找到最优路径")
+	assert.Equal(t, expectedPath, path, "可采纳但不一致的heuristic也应得到最优路径")
+	assert.Equal(t, expectedCost, cost, "路径权重应为5")
+}
+
+func TestAStarGrid(t *testing.T) {
+	type point struct{ x, y int }
+	graph := ggraph.NewGraph[point]()
+	const size = 5
+	for x := 0; x < size; x++ {
+		for y := 0; y < size; y++ {
+			if x+1 < size {
+				graph.AddWeightedEdge(point{x, y}, point{x + 1, y}, 1)
+				graph.AddWeightedEdge(point{x + 1, y}, point{x, y}, 1)
+			}
+			if y+1 < size {
+				graph.AddWeightedEdge(point{x, y}, point{x, y + 1}, 1)
+				graph.AddWeightedEdge(point{x, y + 1}, point{x, y}, 1)
+			}
+		}
+	}
+	goal := point{size - 1, size - 1}
+	manhattan := func(p point) float64 {
+		return float64(goal.x - p.x + goal.y - p.y)
+	}
+
+	path, cost, ok := graph.AStar(point{0, 0}, goal, manhattan)
+	assert.True(t, ok, "网格中应存在路径")
+	assert.Equal(t, float64(2*(size-1)), cost, "曼哈顿启发式下应找到最优路径")
+	assert.Len(t, path, 2*(size-1)+1, "路径长度应为最短")
+}
+
+func TestFloydWarshallMatchesDijkstra(t *testing.T) {
+	graph := newWeightedTestGraph()
+	graph.AddNode("G")
+
+	dist, err := graph.FloydWarshall()
+	assert.NoError(t, err, "无负权环时不应返回错误")
+	for _, from := range graph.Nodes() {
+		for _, to := range graph.Nodes() {
+			_, cost, ok := graph.DijkstraPath(from, to)
+			if !ok {
+				assert.True(t, math.IsInf(dist[from][to], 1), "不可达的节点对距离应为+Inf: %s->%s", from, to)
+				continue
+			}
+			assert.Equal(t, cost, dist[from][to], "距离应与Dijkstra一致: %s->%s", from, to)
+		}
+	}
+}
+
+func TestFloydWarshallNegativeWeights(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddWeightedEdge("A", "B", 4)
+	graph.AddWeightedEdge("A", "C", 5)
+	graph.AddWeightedEdge("C", "B", -2)
+
+	dist, err := graph.FloydWarshall()
+	assert.NoError(t, err, "无负权环时不应返回错误")
+	assert.Equal(t, 3.0, dist["A"]["B"], "负权边应参与计算")
+
+	graph.AddWeightedEdge("B", "C", 1)
+	_, err = graph.FloydWarshall()
+	assert.ErrorIs(t, err, ggraph.ErrNegativeCycle, "存在负权环时应返回ErrNegativeCycle")
+}
+
+func TestAllPaths(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("A", "C")
+	graph.AddEdge("B", "D")
+	graph.AddEdge("C", "D")
+	graph.AddEdge("C", "D") // 平行边
+	graph.AddEdge("D", "A") // 环
+
+	paths := graph.AllPaths("A", "D")
+	assert.Equal(t, [][]string{{"A", "B", "D"}, {"A", "C", "D"}}, paths, "菱形图中应恰好有两条路径")
+
+	assert.Equal(t, [][]string{{"A"}}, graph.AllPaths("A", "A"), "起点与终点相同时应返回零长度路径")
+	assert.Empty(t, graph.AllPaths("A", "Z"), "终点不存在时应返回空结果")
+}
+
+func TestAllPathsBounded(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	graph.AddEdge(1, 4)
+	graph.AddEdge(1, 2)
+	graph.AddEdge(2, 4)
+	graph.AddEdge(2, 3)
+	graph.AddEdge(3, 4)
+
+	assert.Len(t, graph.AllPaths(1, 4), 3, "应有三条路径")
+	assert.Equal(t, [][]int{{1, 4}, {1, 2, 4}}, graph.AllPathsBounded(1, 4, 2, 0), "应只返回边数不超过2的路径")
+	assert.Equal(t, [][]int{{1, 4}}, graph.AllPathsBounded(1, 4, 0, 1), "应至多返回1条路径")
+}
+
+func TestKShortestPaths(t *testing.T) {
+	// Yen算法经典示例
+	graph := ggraph.NewGraph[string]()
+	graph.AddWeightedEdge("C", "D", 3)
+	graph.AddWeightedEdge("C", "E", 2)
+	graph.AddWeightedEdge("D", "F", 4)
+	graph.AddWeightedEdge("E", "D", 1)
+	graph.AddWeightedEdge("E", "F", 2)
+	graph.AddWeightedEdge("E", "G", 3)
+	graph.AddWeightedEdge("F", "G", 2)
+	graph.AddWeightedEdge("F", "H", 1)
+	graph.AddWeightedEdge("G", "H", 2)
+
+	paths, costs := graph.KShortestPaths("C", "H", 3)
+	assert.Equal(t, [][]string{
+		{"C", "E", "F", "H"},
+		{"C", "E", "G", "H"},
+		{"C", "D", "F", "H"},
+	}, paths, "应按总权重递增返回前3条路径")
+	assert.Equal(t, []float64{5, 7, 8}, costs, "路径总权重应正确")
+
+	paths, costs = graph.KShortestPaths("C", "H", 100)
+	assert.Len(t, paths, 7, "可用路径少于k条时应返回全部路径")
+	assert.Len(t, costs, 7, "权重数量应与路径数量一致")
+	assert.IsNonDecreasing(t, costs, "权重应递增")
+}
+
+func TestKShortestPathsNoPath(t *testing.T) {
+	graph := newWeightedTestGraph()
+
+	paths, costs := graph.KShortestPaths("F", "A", 3)
+	assert.Empty(t, paths, "不存在路径时应返回空结果")
+	assert.Empty(t, costs, "不存在路径时应返回空结果")
+	paths, _ = graph.KShortestPaths("A", "D", 0)
+	assert.Empty(t, paths, "k为0时应返回空结果")
+}
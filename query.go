@@ -0,0 +1,68 @@
+package ggraph
+
+import "slices"
+
+// SelfLoops 按索引顺序返回所有带自环（指向自身的边）的节点，每个节点只出现一次
+func (g *Graph[T]) SelfLoops() []T {
+	loops := make([]T, 0)
+	for idx, neighbors := range g.adj {
+		if slices.Contains(neighbors, idx) {
+			loops = append(loops, g.indexToNode[idx])
+		}
+	}
+	return loops
+}
+
+// FindNodes 按索引顺序返回所有满足pred的节点，没有匹配时返回空切片
+func (g *Graph[T]) FindNodes(pred func(T) bool) []T {
+	found := make([]T, 0)
+	for _, node := range g.indexToNode {
+		if pred(node) {
+			found = append(found, node)
+		}
+	}
+	return found
+}
+
+// Roots 按索引顺序返回所有入度为0的节点，即DAG的入口节点；孤立节点同样是根
+// 自环计入入度，因此带自环的节点不是根；整个图为一个大环时返回空切片
+func (g *Graph[T]) Roots() []T {
+	roots := make([]T, 0)
+	for idx, degree := range g.inDegrees() {
+		if degree == 0 {
+			roots = append(roots, g.indexToNode[idx])
+		}
+	}
+	return roots
+}
+
+// Sinks 按索引顺序返回所有出度为0的节点，即处理流程的终点；孤立节点既是根也是汇点
+// 自环计入出度，因此带自环的节点不是汇点
+func (g *Graph[T]) Sinks() []T {
+	sinks := make([]T, 0)
+	for idx, neighbors := range g.adj {
+		if len(neighbors) == 0 {
+			sinks = append(sinks, g.indexToNode[idx])
+		}
+	}
+	return sinks
+}
+
+// ParallelEdges 返回出现超过一次的边，每条只报告一次，按首次出现的顺序（边的索引顺序）排列
+// 边按起止节点区分，权重不参与比较；无向图模式下重复的无向边会以两个方向各报告一次
+func (g *Graph[T]) ParallelEdges() []Edge[T] {
+	parallel := make([]Edge[T], 0)
+	for from, neighbors := range g.adj {
+		counts := make(map[int]int, len(neighbors))
+		for _, to := range neighbors {
+			counts[to]++
+		}
+		for _, to := range neighbors {
+			if counts[to] > 1 {
+				parallel = append(parallel, Edge[T]{From: g.indexToNode[from], To: g.indexToNode[to]})
+				counts[to] = 0 // 已报告
+			}
+		}
+	}
+	return parallel
+}
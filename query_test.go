@@ -0,0 +1,78 @@
+package ggraph_test
+
+import (
+	"testing"
+
+	"github.com/nosusume/ggraph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelfLoops(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "A")
+	graph.AddEdge("A", "A")
+	graph.AddEdge("A", "B")
+	graph.AddEdge("C", "C")
+
+	assert.Equal(t, []string{"A", "C"}, graph.SelfLoops(), "应只返回带自环的节点且不重复")
+	assert.Empty(t, ggraph.NewGraph[int]().SelfLoops(), "空图不应有自环")
+}
+
+func TestFindNodes(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	for _, n := range []int{5, 2, 8, 3, 4} {
+		graph.AddNode(n)
+	}
+
+	even := graph.FindNodes(func(n int) bool { return n%2 == 0 })
+	assert.Equal(t, []int{2, 8, 4}, even, "应按索引顺序返回偶数节点")
+	assert.Empty(t, graph.FindNodes(func(n int) bool { return n > 100 }), "没有匹配时应返回空切片")
+}
+
+func TestRoots(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("B", "C")
+	graph.AddEdge("A", "C")
+	graph.AddEdge("C", "D")
+	graph.AddEdge("A", "E")
+
+	assert.Equal(t, []string{"B", "A"}, graph.Roots(), "应按索引顺序返回两个独立的根")
+
+	graph.AddNode("F")
+	assert.Contains(t, graph.Roots(), "F", "孤立节点也是根")
+
+	cycle := ggraph.NewGraph[int]()
+	for i := 0; i < 5; i++ {
+		cycle.AddEdge(i, (i+1)%5)
+	}
+	assert.Empty(t, cycle.Roots(), "大环中没有根")
+}
+
+func TestSinks(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("A", "C")
+	graph.AddEdge("B", "D")
+	graph.AddEdge("C", "E")
+	graph.AddEdge("C", "C")
+
+	assert.Equal(t, []string{"D", "E"}, graph.Sinks(), "应按索引顺序返回所有汇点")
+
+	graph.AddNode("F")
+	assert.Contains(t, graph.Sinks(), "F", "孤立节点应是汇点")
+	assert.Contains(t, graph.Roots(), "F", "孤立节点同时是根")
+}
+
+func TestParallelEdges(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("A", "C")
+	graph.AddWeightedEdge("A", "B", 5) // 权重不同仍视为平行边
+	graph.AddEdge("C", "A")
+	graph.AddEdge("A", "B")
+
+	assert.Equal(t, []ggraph.Edge[string]{{From: "A", To: "B"}}, graph.ParallelEdges(), "只应报告出现三次的边，且只报告一次")
+
+	graph.Simplify(false)
+	assert.Empty(t, graph.ParallelEdges(), "Simplify后不应有平行边")
+}
@@ -0,0 +1,128 @@
+package ggraph
+
+// TransitiveClosure 返回图的传递闭包：A->B存在当且仅当B可经长度≥1的有向路径从A到达
+// 因此只有位于环上（包括自环）的节点才带有自环；结果不含平行边，权重均为1
+func (g *Graph[T]) TransitiveClosure() *Graph[T] {
+	closure := NewGraph[T]()
+	for _, node := range g.indexToNode {
+		closure.AddNode(node)
+	}
+	for from := range g.adj {
+		for to, ok := range g.reachableFrom(from) {
+			if ok {
+				closure.addEdge(g.indexToNode[from], g.indexToNode[to], 1)
+			}
+		}
+	}
+	return closure
+}
+
+// TransitiveReduction 返回DAG的传递归约：与原图可达关系相同、边数最少的子图
+// 若存在从u到v的更长路径，则边u->v是冗余的并被删除；平行边只保留第一条，保留边的权重与属性随之复制
+// 图中有环时返回ErrCyclicGraph；时间复杂度O(V·(V+E))
+func (g *Graph[T]) TransitiveReduction() (*Graph[T], error) {
+	if g.HasCycle() {
+		return nil, ErrCyclicGraph
+	}
+	reach := make([][]bool, len(g.nodes))
+	for idx := range reach {
+		reach[idx] = g.reachableFrom(idx)
+	}
+	kept := make(map[[2]int]bool)
+	return g.filter(func(int) bool {
+		return true
+	}, func(from, to int) bool {
+		if kept[[2]int{from, to}] {
+			return false
+		}
+		for _, via := range g.adj[from] {
+			if via != to && reach[via][to] {
+				return false
+			}
+		}
+		kept[[2]int{from, to}] = true
+		return true
+	}), nil
+}
+
+// Reachable 按索引顺序返回从start沿有向边可到达的所有节点
+// 不包含start自身，除非start位于可回到自身的环上；start不存在时返回空切片
+func (g *Graph[T]) Reachable(start T) []T {
+	startIndex, exists := g.nodes[start]
+	if !exists {
+		return []T{}
+	}
+	return g.markedNodes(g.reachableFrom(startIndex))
+}
+
+// CanReach 返回从from沿有向边是否可到达to，BFS找到to时立即返回，不计算完整的可达集合
+// from与to相同且节点存在时返回true；任一端点不存在时返回false
+func (g *Graph[T]) CanReach(from, to T) bool {
+	fromIndex, fromExists := g.nodes[from]
+	toIndex, toExists := g.nodes[to]
+	if !fromExists || !toExists {
+		return false
+	}
+	return g.bfsPrev(fromIndex, toIndex)[toIndex] != -1
+}
+
+// markedNodes 按索引顺序返回被标记的节点
+func (g *Graph[T]) markedNodes(marked []bool) []T {
+	nodes := make([]T, 0)
+	for idx, ok := range marked {
+		if ok {
+			nodes = append(nodes, g.indexToNode[idx])
+		}
+	}
+	return nodes
+}
+
+// Descendants 按索引顺序返回node的所有后代，即沿有向边可到达的节点，与Reachable相同
+// 不包含node自身，除非node位于环上；node不存在时返回空切片
+func (g *Graph[T]) Descendants(node T) []T {
+	return g.Reachable(node)
+}
+
+// Ancestors 按索引顺序返回node的所有祖先，即存在到node的有向路径的节点
+// 不包含node自身，除非node位于环上；node不存在时返回空切片
+func (g *Graph[T]) Ancestors(node T) []T {
+	index, exists := g.nodes[node]
+	if !exists {
+		return []T{}
+	}
+	return g.markedNodes(reachableIn(g.reverseAdj(), index))
+}
+
+// reverseAdj 返回所有边反向后的邻接表，平行边按重数保留
+func (g *Graph[T]) reverseAdj() [][]int {
+	reverse := make([][]int, len(g.nodes))
+	for from, neighbors := range g.adj {
+		for _, to := range neighbors {
+			reverse[to] = append(reverse[to], from)
+		}
+	}
+	return reverse
+}
+
+// reachableFrom 返回从start索引经长度≥1的有向路径可到达的节点标记
+// start自身仅在位于环上时被标记
+func (g *Graph[T]) reachableFrom(start int) []bool {
+	return reachableIn(g.adj, start)
+}
+
+// reachableIn 在邻接表adj上返回从start经长度≥1的路径可到达的节点标记
+func reachableIn(adj [][]int, start int) []bool {
+	reached := make([]bool, len(adj))
+	queue := []int{start}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, next := range adj[current] {
+			if !reached[next] {
+				reached[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	return reached
+}
@@ -0,0 +1,97 @@
+package ggraph_test
+
+import (
+	"testing"
+
+	"github.com/nosusume/ggraph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransitiveClosure(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("a", "b")
+	graph.AddEdge("b", "c")
+	graph.AddEdge("x", "y")
+	graph.AddEdge("y", "x")
+
+	closure := graph.TransitiveClosure()
+	assert.True(t, closure.HasEdge("a", "c"), "闭包应包含a->c")
+	assert.True(t, closure.HasEdge("a", "b"), "闭包应包含原有边")
+	assert.False(t, closure.HasEdge("c", "a"), "闭包不应包含反向边")
+	assert.False(t, closure.HasEdge("a", "a"), "不在环上的节点不应有自环")
+	assert.True(t, closure.HasEdge("x", "x"), "环上的节点应有自环")
+	assert.Equal(t, 7, closure.EdgeCount(), "闭包边数量应为7")
+	assert.Equal(t, 4, graph.EdgeCount(), "原图不应被修改")
+}
+
+func TestReachable(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", "C")
+	graph.AddEdge("C", "B") // 环
+	graph.AddEdge("A", "D") // 死胡同分支
+	graph.AddEdge("E", "A")
+
+	assert.Equal(t, []string{"B", "C", "D"}, graph.Reachable("A"), "应返回A可到达的节点且不含A")
+	assert.Equal(t, []string{"B", "C"}, graph.Reachable("B"), "位于环上时应包含自身")
+	assert.Empty(t, graph.Reachable("D"), "死胡同节点不可到达任何节点")
+	assert.Empty(t, graph.Reachable("Z"), "不存在的节点应返回空切片")
+}
+
+func TestDescendantsAndAncestors(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("a", "b")
+	graph.AddEdge("b", "c")
+
+	assert.Equal(t, []string{"b", "c"}, graph.Descendants("a"), "a的后代应为b和c")
+	assert.Equal(t, []string{"a", "b"}, graph.Ancestors("c"), "c的祖先应为a和b")
+	assert.Empty(t, graph.Descendants("c"), "汇点没有后代")
+	assert.Empty(t, graph.Ancestors("a"), "源点没有祖先")
+	assert.Empty(t, graph.Ancestors("x"), "节点不存在时应返回空切片")
+
+	graph.AddEdge("c", "b")
+	assert.Equal(t, []string{"a", "b", "c"}, graph.Ancestors("b"), "位于环上的节点应包含自身")
+}
+
+func TestCanReach(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	for i := 0; i < 100; i++ {
+		graph.AddEdge(i, i+1)
+	}
+	graph.AddEdge(200, 0)
+
+	assert.True(t, graph.CanReach(0, 100), "应可经长路径到达")
+	assert.True(t, graph.CanReach(200, 50), "应可经长路径到达")
+	assert.False(t, graph.CanReach(100, 0), "不应逆着边的方向到达")
+	assert.False(t, graph.CanReach(0, 200), "不存在路径时应返回false")
+	assert.True(t, graph.CanReach(42, 42), "节点到自身应可达")
+	assert.False(t, graph.CanReach(0, 999), "终点不存在时应返回false")
+	assert.False(t, graph.CanReach(999, 999), "节点不存在时应返回false")
+}
+
+func TestTransitiveReduction(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("a", "b")
+	graph.AddEdge("b", "c")
+	graph.AddEdge("a", "c")
+
+	reduced, err := graph.TransitiveReduction()
+	assert.NoError(t, err, "DAG不应返回错误")
+	assert.False(t, reduced.HasEdge("a", "c"), "冗余边a->c应被删除")
+	assert.True(t, reduced.HasEdge("a", "b"), "边a->b应保留")
+	assert.True(t, reduced.HasEdge("b", "c"), "边b->c应保留")
+	assert.Equal(t, 2, reduced.EdgeCount(), "归约后应只剩2条边")
+	assert.True(t, graph.HasEdge("a", "c"), "原图不应被修改")
+	assert.True(t, graph.TransitiveClosure().Equal(reduced.TransitiveClosure()), "归约后可达关系应不变")
+
+	graph.AddEdge("c", "d")
+	graph.AddEdge("a", "d")
+	graph.AddEdge("b", "d")
+	graph.AddEdge("c", "d") // 平行边
+	reduced, _ = graph.TransitiveReduction()
+	assert.Equal(t, []ggraph.Edge[string]{{From: "a", To: "b"}, {From: "b", To: "c"}, {From: "c", To: "d"}}, reduced.Edges(), "应只保留链上的边")
+
+	graph.AddEdge("d", "a")
+	_, err = graph.TransitiveReduction()
+	assert.ErrorIs(t, err, ggraph.ErrCyclicGraph, "有环图应返回ErrCyclicGraph")
+}
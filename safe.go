@@ -0,0 +1,91 @@
+package ggraph
+
+import "sync"
+
+// SafeGraph 并发安全的图包装，读方法持有读锁，写方法持有写锁
+type SafeGraph[T comparable] struct {
+	mu    sync.RWMutex
+	graph *Graph[T]
+}
+
+// NewSafeGraph 初始化一个空的并发安全图
+func NewSafeGraph[T comparable]() *SafeGraph[T] {
+	return &SafeGraph[T]{graph: NewGraph[T]()}
+}
+
+// NewSafeGraphFrom 以g为底层图创建并发安全图，此后不应再直接访问g
+func NewSafeGraphFrom[T comparable](g *Graph[T]) *SafeGraph[T] {
+	return &SafeGraph[T]{graph: g}
+}
+
+// AddNode 向图中添加一个节点（去重）
+func (s *SafeGraph[T]) AddNode(node T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.graph.AddNode(node)
+}
+
+// AddEdge 添加一条从from到to的有向边（自动添加缺失节点）
+func (s *SafeGraph[T]) AddEdge(from, to T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.graph.AddEdge(from, to)
+}
+
+// HasNode 检查图中是否存在指定节点
+func (s *SafeGraph[T]) HasNode(node T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.graph.HasNode(node)
+}
+
+// HasEdge 检查是否存在从from到to的有向边
+func (s *SafeGraph[T]) HasEdge(from, to T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.graph.HasEdge(from, to)
+}
+
+// Neighbors 返回指定节点的所有邻居
+func (s *SafeGraph[T]) Neighbors(node T) []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.graph.Neighbors(node)
+}
+
+// Nodes 返回图中所有节点的切片
+func (s *SafeGraph[T]) Nodes() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.graph.Nodes()
+}
+
+// Edges 返回图中所有边的切片
+func (s *SafeGraph[T]) Edges() []Edge[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.graph.Edges()
+}
+
+// Read 在持有读锁期间以底层图调用fn，用于执行任意只读操作（如遍历、最短路径）
+// fn不得修改图，也不得在返回后继续持有图
+func (s *SafeGraph[T]) Read(fn func(g *Graph[T])) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	fn(s.graph)
+}
+
+// Write 在持有写锁期间以底层图调用fn，用于批量修改
+// fn不得在返回后继续持有图
+func (s *SafeGraph[T]) Write(fn func(g *Graph[T])) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fn(s.graph)
+}
+
+// Snapshot 返回底层图的深拷贝，可在锁外自由使用
+func (s *SafeGraph[T]) Snapshot() *Graph[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.graph.Clone()
+}
@@ -0,0 +1,58 @@
+package ggraph_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/nosusume/ggraph"
+	"github.com/stretchr/testify/assert"
+)
+
+// 使用go test -race运行以检测数据竞争
+func TestSafeGraphConcurrent(t *testing.T) {
+	graph := ggraph.NewSafeGraph[int]()
+	const workers, perWorker = 8, 200
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(2)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				graph.AddEdge(w*perWorker+i, w*perWorker+i+1)
+			}
+		}(w)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				graph.HasNode(i)
+				graph.HasEdge(i, i+1)
+				graph.Neighbors(w)
+				graph.Nodes()
+				graph.Edges()
+				graph.Read(func(g *ggraph.Graph[int]) {
+					g.Reachable(0)
+				})
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	snapshot := graph.Snapshot()
+	assert.Equal(t, workers*perWorker, snapshot.EdgeCount(), "所有并发添加的边都应存在")
+	assert.True(t, graph.HasEdge(0, 1), "边0->1应存在")
+}
+
+func TestSafeGraphWrite(t *testing.T) {
+	base := ggraph.NewGraph[string]()
+	base.AddEdge("A", "B")
+	graph := ggraph.NewSafeGraphFrom(base)
+
+	graph.Write(func(g *ggraph.Graph[string]) {
+		g.RemoveEdge("A", "B")
+		g.AddEdge("B", "A")
+	})
+	assert.False(t, graph.HasEdge("A", "B"), "Write中的修改应生效")
+	assert.True(t, graph.HasEdge("B", "A"), "Write中的修改应生效")
+	assert.ElementsMatch(t, []string{"A", "B"}, graph.Nodes(), "节点应保留")
+}
@@ -0,0 +1,272 @@
+package ggraph
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// Transpose 返回将所有有向边反向后的新图（from->to变为to->from）
+// 节点集合、节点顺序及边权重保持不变，自环和平行边均保留；原图不会被修改
+func (g *Graph[T]) Transpose() *Graph[T] {
+	t := NewGraph[T]()
+	t.undirected = g.undirected
+	for _, node := range g.indexToNode {
+		t.AddNode(node)
+	}
+	for from, neighbors := range g.adj {
+		for i, to := range neighbors {
+			t.addEdge(g.indexToNode[to], g.indexToNode[from], g.weights[from][i])
+		}
+	}
+	return t
+}
+
+// ReverseInPlace 原地将所有有向边反向（from->to变为to->from），是Transpose的就地版本
+// 复用节点表与属性表，只重建邻接表；边权重和边属性随边反向，自环和平行边均保留
+func (g *Graph[T]) ReverseInPlace() {
+	g.mustBeMutable()
+	inDegree := g.inDegrees()
+	adj := make([][]int, len(g.adj))
+	weights := make([][]float64, len(g.weights))
+	for idx, degree := range inDegree {
+		adj[idx] = make([]int, 0, degree)
+		weights[idx] = make([]float64, 0, degree)
+	}
+	for from, neighbors := range g.adj {
+		for i, to := range neighbors {
+			adj[to] = append(adj[to], from)
+			weights[to] = append(weights[to], g.weights[from][i])
+		}
+	}
+	g.adj, g.weights = adj, weights
+	if len(g.edgeAttrs) > 0 {
+		reversed := make(map[Edge[T]]map[string]any, len(g.edgeAttrs))
+		for edge, attrs := range g.edgeAttrs {
+			reversed[Edge[T]{From: edge.To, To: edge.From}] = attrs
+		}
+		g.edgeAttrs = reversed
+	}
+}
+
+// Clone 返回图的深拷贝（包括节点和边的属性表，属性值本身为浅拷贝），对副本的任何修改都不会影响原图
+// 冻结图的副本是未冻结的
+func (g *Graph[T]) Clone() *Graph[T] {
+	c := &Graph[T]{
+		nodes:       maps.Clone(g.nodes),
+		indexToNode: slices.Clone(g.indexToNode),
+		adj:         g.cloneAdj(),
+		weights:     make([][]float64, len(g.weights)),
+		undirected:  g.undirected,
+	}
+	for i, weights := range g.weights {
+		c.weights[i] = slices.Clone(weights)
+	}
+	if g.nodeAttrs != nil {
+		c.nodeAttrs = make(map[T]map[string]any, len(g.nodeAttrs))
+		for node, attrs := range g.nodeAttrs {
+			c.nodeAttrs[node] = maps.Clone(attrs)
+		}
+	}
+	if g.edgeAttrs != nil {
+		c.edgeAttrs = make(map[Edge[T]]map[string]any, len(g.edgeAttrs))
+		for edge, attrs := range g.edgeAttrs {
+			c.edgeAttrs[edge] = maps.Clone(attrs)
+		}
+	}
+	return c
+}
+
+// Merge 将other的所有节点和边并入g（修改g），other不受影响
+// 两图中的相同边作为平行边全部保留，边权重随边复制；如需去重请使用MergeUnique
+func (g *Graph[T]) Merge(other *Graph[T]) {
+	g.merge(other, false)
+}
+
+// MergeUnique 将other的所有节点和边并入g（修改g），但跳过g中已存在的边
+// 因此两图共有的边合并后只保留一条（保留g中原有边的权重）
+func (g *Graph[T]) MergeUnique(other *Graph[T]) {
+	g.merge(other, true)
+}
+
+// merge 按有向边逐条将other并入g，unique为true时跳过已存在的边
+func (g *Graph[T]) merge(other *Graph[T], unique bool) {
+	g.mustBeMutable()
+	for _, node := range other.indexToNode {
+		g.AddNode(node)
+	}
+	for from, neighbors := range other.adj {
+		fromNode := other.indexToNode[from]
+		for i, to := range neighbors {
+			toNode := other.indexToNode[to]
+			if unique && g.HasEdge(fromNode, toNode) {
+				continue
+			}
+			g.addEdge(fromNode, toNode, other.weights[from][i])
+		}
+	}
+}
+
+// Simplify 合并平行边，使每个有序节点对至多保留一条边（保留最早添加的一条及其权重）
+// removeSelfLoops为true时同时删除所有自环
+func (g *Graph[T]) Simplify(removeSelfLoops bool) {
+	g.mustBeMutable()
+	seen := make([]bool, len(g.nodes))
+	for from, neighbors := range g.adj {
+		kept := neighbors[:0]
+		keptWeights := g.weights[from][:0]
+		for i, to := range neighbors {
+			if seen[to] || (removeSelfLoops && to == from) {
+				continue
+			}
+			seen[to] = true
+			kept = append(kept, to)
+			keptWeights = append(keptWeights, g.weights[from][i])
+		}
+		for _, to := range kept {
+			seen[to] = false
+		}
+		g.adj[from] = kept
+		g.weights[from] = keptWeights
+		if removeSelfLoops {
+			node := g.indexToNode[from]
+			delete(g.edgeAttrs, Edge[T]{From: node, To: node})
+		}
+	}
+}
+
+// Subgraph 返回由nodes导出的子图：包含nodes中在g里存在的节点，以及两端点都在该集合中的所有边
+// g中不存在的节点被忽略；节点保持g中的相对顺序，边权重、属性及无向图模式一并保留
+func (g *Graph[T]) Subgraph(nodes []T) *Graph[T] {
+	keep := make([]bool, len(g.nodes))
+	for _, node := range nodes {
+		if idx, exists := g.nodes[node]; exists {
+			keep[idx] = true
+		}
+	}
+	return g.filter(func(idx int) bool {
+		return keep[idx]
+	}, func(from, to int) bool {
+		return keep[from] && keep[to]
+	})
+}
+
+// filter 返回只保留满足keepNode的节点和满足keepEdge的边的新图
+// keepEdge只会对两端点均被保留的边调用；权重、属性及无向图模式一并复制
+func (g *Graph[T]) filter(keepNode func(idx int) bool, keepEdge func(from, to int) bool) *Graph[T] {
+	result := NewGraph[T]()
+	result.undirected = g.undirected
+	for idx, node := range g.indexToNode {
+		if keepNode(idx) {
+			result.AddNode(node)
+			for key, value := range g.nodeAttrs[node] {
+				result.SetNodeAttr(node, key, value)
+			}
+		}
+	}
+	for from, neighbors := range g.adj {
+		for i, to := range neighbors {
+			if keepNode(from) && keepNode(to) && keepEdge(from, to) {
+				result.addEdge(g.indexToNode[from], g.indexToNode[to], g.weights[from][i])
+			}
+		}
+	}
+	for edge, attrs := range g.edgeAttrs {
+		if result.HasEdge(edge.From, edge.To) {
+			for key, value := range attrs {
+				result.setEdgeAttr(edge, key, value)
+			}
+		}
+	}
+	return result
+}
+
+// FilterEdges 返回保留所有节点、但只保留keep返回true的边的新图，原图不会被修改
+// 边权重、属性及无向图模式一并保留
+func (g *Graph[T]) FilterEdges(keep func(from, to T) bool) *Graph[T] {
+	return g.filter(func(int) bool {
+		return true
+	}, func(from, to int) bool {
+		return keep(g.indexToNode[from], g.indexToNode[to])
+	})
+}
+
+// MapGraph 返回将g的每个节点经f转换后得到的新图，边在转换后的节点之间保留（含权重）
+// 若f将多个不同节点映射为同一个值，它们合并为一个节点，各自的边都连到该节点上：
+// 边按原样保留为平行边，原本位于被合并节点之间的边变为自环；节点属性不会被复制
+func MapGraph[T, U comparable](g *Graph[T], f func(T) U) *Graph[U] {
+	mapped := NewGraph[U]()
+	mapped.undirected = g.undirected
+	values := make([]U, len(g.indexToNode))
+	for idx, node := range g.indexToNode {
+		values[idx] = f(node)
+		mapped.AddNode(values[idx])
+	}
+	for from, neighbors := range g.adj {
+		for i, to := range neighbors {
+			mapped.addEdge(values[from], values[to], g.weights[from][i])
+		}
+	}
+	return mapped
+}
+
+// Relabel 返回按mapping重命名节点后的新图，不在mapping中的节点保持原值，原图不会被修改
+// 多个不同节点被映射为同一个值时按MapGraph的规则合并：边保留为平行边，它们之间的边变为自环；
+// 节点和边的属性随重命名复制，合并时按索引顺序靠后的节点（边）的同名属性覆盖靠前的
+// mapping中的键不是图中的节点时返回ErrNodeNotFound
+func (g *Graph[T]) Relabel(mapping map[T]T) (*Graph[T], error) {
+	for node := range mapping {
+		if !g.HasNode(node) {
+			return nil, fmt.Errorf("%w: %v", ErrNodeNotFound, node)
+		}
+	}
+	label := func(node T) T {
+		if renamed, ok := mapping[node]; ok {
+			return renamed
+		}
+		return node
+	}
+	relabeled := MapGraph(g, label)
+	for _, node := range g.indexToNode {
+		for key, value := range g.nodeAttrs[node] {
+			relabeled.SetNodeAttr(label(node), key, value)
+		}
+	}
+	for from, neighbors := range g.adj {
+		for _, to := range neighbors {
+			edge := Edge[T]{From: g.indexToNode[from], To: g.indexToNode[to]}
+			for key, value := range g.edgeAttrs[edge] {
+				relabeled.setEdgeAttr(Edge[T]{From: label(edge.From), To: label(edge.To)}, key, value)
+			}
+		}
+	}
+	return relabeled, nil
+}
+
+// ContractEdge 收缩从from到to的边：将to合并到from中，to的所有关联边改为连到from（保留权重），
+// 然后删除to；合并产生的自环（原from与to之间的边及to的自环）被丢弃，from原有的自环保留
+// 边不存在或from与to相同时返回false且不修改图；to的节点属性及被改连边的属性不会保留
+func (g *Graph[T]) ContractEdge(from, to T) bool {
+	g.mustBeMutable()
+	if from == to || !g.HasEdge(from, to) {
+		return false
+	}
+	fromIndex, toIndex := g.nodes[from], g.nodes[to]
+	for i, next := range g.adj[toIndex] {
+		if next != fromIndex && next != toIndex {
+			g.addEdge(from, g.indexToNode[next], g.weights[toIndex][i])
+		}
+	}
+	for src, neighbors := range g.adj {
+		if src == fromIndex || src == toIndex {
+			continue
+		}
+		for i, next := range neighbors {
+			if next == toIndex {
+				g.addEdge(g.indexToNode[src], from, g.weights[src][i])
+			}
+		}
+	}
+	g.RemoveNode(to)
+	return true
+}
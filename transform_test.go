@@ -0,0 +1,271 @@
+package ggraph_test
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/nosusume/ggraph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTranspose(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddWeightedEdge("A", "B", 3)
+	graph.AddEdge("A", "B") // 平行边
+	graph.AddEdge("B", "C")
+	graph.AddEdge("C", "C") // 自环
+	graph.AddNode("D")
+
+	transposed := graph.Transpose()
+	assert.True(t, transposed.HasEdge("B", "A"), "边A->B应反向为B->A")
+	assert.False(t, transposed.HasEdge("A", "B"), "反向后不应存在A->B")
+	assert.True(t, transposed.HasEdge("C", "C"), "自环应保留")
+	assert.True(t, transposed.HasNode("D"), "孤立节点应保留")
+	assert.Equal(t, graph.EdgeCount(), transposed.EdgeCount(), "边数量应不变")
+	weight, _ := transposed.EdgeWeight("B", "A")
+	assert.Equal(t, 3.0, weight, "边权重应保留")
+
+	assert.True(t, graph.HasEdge("A", "B"), "原图不应被修改")
+	assert.False(t, graph.HasEdge("B", "A"), "原图不应被修改")
+
+	assert.ElementsMatch(t, graph.Edges(), transposed.Transpose().Edges(), "两次转置后边集合应与原图一致")
+}
+
+func TestTransposeUndirected(t *testing.T) {
+	graph := ggraph.NewUndirectedGraph[int]()
+	graph.AddEdge(1, 2)
+
+	transposed := graph.Transpose()
+	assert.True(t, transposed.IsUndirected(), "转置应保留无向图模式")
+	assert.Equal(t, graph.EdgeCount(), transposed.EdgeCount(), "无向图转置后边数量应不变")
+}
+
+func TestReverseInPlace(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddWeightedEdge("A", "B", 3)
+	graph.AddEdge("A", "B") // 平行边
+	graph.AddEdge("B", "C")
+	graph.AddEdge("C", "C") // 自环
+	graph.AddNode("D")
+	assert.NoError(t, graph.SetEdgeAttr("B", "C", "label", "bc"), "设置边属性不应出错")
+	expected := graph.Transpose().Edges()
+
+	graph.ReverseInPlace()
+	assert.True(t, graph.HasEdge("B", "A"), "边A->B应反向为B->A")
+	assert.False(t, graph.HasEdge("A", "B"), "反向后不应存在A->B")
+	assert.True(t, graph.HasEdge("C", "C"), "自环应保持不变")
+	assert.Equal(t, 4, graph.EdgeCount(), "平行边应全部保留")
+	assert.ElementsMatch(t, expected, graph.Edges(), "结果应与Transpose一致")
+	weight, _ := graph.EdgeWeight("B", "A")
+	assert.Equal(t, 3.0, weight, "边权重应随边反向")
+	value, ok := graph.EdgeAttr("C", "B", "label")
+	assert.True(t, ok, "边属性应随边反向")
+	assert.Equal(t, "bc", value, "边属性值应保留")
+
+	graph.ReverseInPlace()
+	assert.True(t, graph.HasEdge("A", "B"), "两次反向后应恢复原方向")
+}
+
+func TestClone(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddWeightedEdge("A", "B", 2)
+	graph.AddEdge("B", "C")
+
+	clone := graph.Clone()
+	assert.ElementsMatch(t, graph.Edges(), clone.Edges(), "副本的边应与原图一致")
+
+	clone.AddEdge("C", "A")
+	clone.AddWeightedEdge("A", "B", 7)
+	clone.RemoveEdge("B", "C")
+	clone.RemoveNode("A")
+	clone.AddNode("D")
+
+	assert.Equal(t, 3, graph.NodeCount(), "原图节点数量不应变化")
+	assert.Equal(t, 2, graph.EdgeCount(), "原图边数量不应变化")
+	assert.True(t, graph.HasEdge("B", "C"), "原图的边不应被删除")
+	assert.False(t, graph.HasEdge("C", "A"), "原图不应新增边")
+	assert.False(t, graph.HasNode("D"), "原图不应新增节点")
+	weight, _ := graph.EdgeWeight("A", "B")
+	assert.Equal(t, 2.0, weight, "原图的边权重不应变化")
+}
+
+func TestToDTODoesNotExposeInternalState(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	graph.AddEdge(1, 2)
+
+	dto := graph.ToDTO()
+	dto.Adj[0][0] = 0
+	dto.Adj[0] = append(dto.Adj[0], 0)
+	assert.True(t, graph.HasEdge(1, 2), "修改DTO不应影响原图")
+	assert.Equal(t, 1, graph.EdgeCount(), "修改DTO不应影响原图")
+}
+
+func TestMerge(t *testing.T) {
+	a := ggraph.NewGraph[string]()
+	a.AddEdge("A", "B")
+	a.AddEdge("B", "C")
+
+	b := ggraph.NewGraph[string]()
+	b.AddEdge("B", "C")
+	b.AddWeightedEdge("C", "D", 3)
+	b.AddNode("E")
+
+	merged := a.Clone()
+	merged.Merge(b)
+	assert.Equal(t, 5, merged.NodeCount(), "节点应取并集")
+	assert.Equal(t, 4, merged.EdgeCount(), "共有边应作为平行边保留")
+	weight, _ := merged.EdgeWeight("C", "D")
+	assert.Equal(t, 3.0, weight, "边权重应随边复制")
+	assert.Equal(t, 2, b.EdgeCount(), "other不应被修改")
+
+	unique := a.Clone()
+	unique.MergeUnique(b)
+	assert.Equal(t, 5, unique.NodeCount(), "节点应取并集")
+	assert.Equal(t, 3, unique.EdgeCount(), "共有边应只保留一条")
+	assert.True(t, unique.HasEdge("C", "D"), "other独有的边应被并入")
+}
+
+func TestSimplify(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	graph.AddWeightedEdge(1, 2, 5)
+	graph.AddEdge(1, 2)
+	graph.AddEdge(1, 2)
+	graph.AddEdge(2, 2)
+	graph.AddEdge(2, 2)
+
+	graph.Simplify(false)
+	assert.Equal(t, 2, graph.EdgeCount(), "平行边应合并，自环应保留一条")
+	weight, _ := graph.EdgeWeight(1, 2)
+	assert.Equal(t, 5.0, weight, "应保留最早添加的边的权重")
+
+	graph.Simplify(true)
+	assert.Equal(t, 1, graph.EdgeCount(), "自环应被删除")
+	assert.False(t, graph.HasEdge(2, 2), "自环应被删除")
+	assert.True(t, graph.HasEdge(1, 2), "普通边应保留")
+}
+
+func TestSubgraph(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddWeightedEdge("A", "B", 2)
+	graph.AddEdge("B", "C")
+	graph.AddEdge("C", "A")
+	graph.AddEdge("C", "D")
+	graph.AddEdge("D", "A")
+
+	sub := graph.Subgraph([]string{"C", "A", "B", "Z"})
+	assert.Equal(t, []string{"A", "B", "C"}, slices.Collect(sub.AllNodes()), "应只包含存在的给定节点并保持原顺序")
+	assert.ElementsMatch(t, []ggraph.Edge[string]{{From: "A", To: "B"}, {From: "B", To: "C"}, {From: "C", To: "A"}},
+		sub.Edges(), "一端在集合外的边应被排除")
+	weight, _ := sub.EdgeWeight("A", "B")
+	assert.Equal(t, 2.0, weight, "边权重应保留")
+	assert.Equal(t, 5, graph.EdgeCount(), "原图不应被修改")
+}
+
+func TestFilterEdges(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("A", "C")
+	graph.AddEdge("B", "C")
+	graph.AddEdge("C", "A")
+
+	none := graph.FilterEdges(func(string, string) bool { return false })
+	assert.Equal(t, graph.NodeCount(), none.NodeCount(), "应保留全部节点")
+	assert.Zero(t, none.EdgeCount(), "应过滤掉全部边")
+
+	fromA := graph.FilterEdges(func(from, _ string) bool { return from == "A" })
+	assert.ElementsMatch(t, []ggraph.Edge[string]{{From: "A", To: "B"}, {From: "A", To: "C"}}, fromA.Edges(), "应只保留从A出发的边")
+	assert.Equal(t, 4, graph.EdgeCount(), "原图不应被修改")
+}
+
+func TestMapGraph(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	graph.AddEdge(1, 2)
+	graph.AddWeightedEdge(2, 3, 4)
+	graph.AddNode(7)
+
+	mapped := ggraph.MapGraph(graph, strconv.Itoa)
+	assert.Equal(t, []string{"1", "2", "3", "7"}, slices.Collect(mapped.AllNodes()), "节点应按顺序转换")
+	assert.True(t, mapped.HasEdge("1", "2"), "边1->2应保留")
+	assert.True(t, mapped.HasEdge("2", "3"), "边2->3应保留")
+	weight, _ := mapped.EdgeWeight("2", "3")
+	assert.Equal(t, 4.0, weight, "边权重应保留")
+	assert.Equal(t, graph.EdgeCount(), mapped.EdgeCount(), "边数量应一致")
+}
+
+func TestMapGraphCollapse(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	graph.AddEdge(1, 2)
+	graph.AddEdge(3, 2)
+	graph.AddEdge(2, 4)
+
+	parity := ggraph.MapGraph(graph, func(n int) string {
+		if n%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	})
+	assert.Equal(t, 2, parity.NodeCount(), "映射为相同值的节点应合并")
+	assert.Equal(t, 3, parity.EdgeCount(), "合并后边应全部保留")
+	assert.True(t, parity.HasEdge("odd", "even"), "边应连到合并后的节点")
+	assert.True(t, parity.HasEdge("even", "even"), "合并节点之间的边应变为自环")
+}
+
+func TestRelabel(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddWeightedEdge("a", "b", 2)
+	graph.AddEdge("b", "c")
+	graph.SetNodeAttr("a", "color", "red")
+	assert.NoError(t, graph.SetEdgeAttr("a", "b", "label", "ab"), "设置边属性不应出错")
+
+	relabeled, err := graph.Relabel(map[string]string{"a": "A", "b": "B"})
+	assert.NoError(t, err, "重命名不应出错")
+	assert.Equal(t, []string{"A", "B", "c"}, relabeled.Nodes(), "未映射的节点应保持原值")
+	assert.True(t, relabeled.HasEdge("A", "B"), "边应在重命名后的节点之间保留")
+	assert.True(t, relabeled.HasEdge("B", "c"), "边应在重命名后的节点之间保留")
+	weight, _ := relabeled.EdgeWeight("A", "B")
+	assert.Equal(t, 2.0, weight, "边权重应保留")
+	value, _ := relabeled.NodeAttr("A", "color")
+	assert.Equal(t, "red", value, "节点属性应随重命名复制")
+	value, _ = relabeled.EdgeAttr("A", "B", "label")
+	assert.Equal(t, "ab", value, "边属性应随重命名复制")
+	assert.True(t, graph.HasNode("a"), "原图不应被修改")
+
+	_, err = graph.Relabel(map[string]string{"x": "y"})
+	assert.ErrorIs(t, err, ggraph.ErrNodeNotFound, "映射的键不存在时应返回ErrNodeNotFound")
+}
+
+func TestRelabelMerge(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("a", "c")
+	graph.AddEdge("b", "c")
+	graph.AddEdge("a", "b")
+
+	merged, err := graph.Relabel(map[string]string{"b": "a"})
+	assert.NoError(t, err, "合并不应出错")
+	assert.Equal(t, []string{"a", "c"}, merged.Nodes(), "映射到相同标签的节点应合并")
+	assert.Equal(t, 3, merged.EdgeCount(), "合并后边应全部保留")
+	assert.Equal(t, []string{"c", "a", "c"}, merged.Neighbors("a"), "合并节点的边应保留为平行边")
+	assert.True(t, merged.HasEdge("a", "a"), "被合并节点之间的边应变为自环")
+}
+
+func TestContractEdge(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", "A")
+	graph.AddEdge("A", "C")
+	graph.AddWeightedEdge("B", "D", 3)
+	graph.AddEdge("E", "B")
+	graph.AddEdge("B", "B")
+
+	assert.False(t, graph.ContractEdge("C", "A"), "边不存在时应返回false")
+	assert.True(t, graph.ContractEdge("A", "B"), "收缩存在的边应返回true")
+
+	assert.False(t, graph.HasNode("B"), "B应被合并删除")
+	assert.ElementsMatch(t, []string{"C", "D"}, graph.Neighbors("A"), "合并后A应拥有两者的邻居")
+	assert.True(t, graph.HasEdge("E", "A"), "指向B的边应改为指向A")
+	assert.False(t, graph.HasEdge("A", "A"), "合并产生的自环应被丢弃")
+	weight, _ := graph.EdgeWeight("A", "D")
+	assert.Equal(t, 3.0, weight, "改连边的权重应保留")
+	assert.Equal(t, 3, graph.EdgeCount(), "边数量应为3")
+}
@@ -0,0 +1,166 @@
+package ggraph
+
+import "math/rand/v2"
+
+// BFS 从start开始按有向边进行广度优先遍历
+// 节点按层级依次访问，同一层内按边的插入顺序访问，每个节点至多访问一次
+// visit返回false时立即停止遍历；start不存在时不做任何操作
+func (g *Graph[T]) BFS(start T, visit func(node T) bool) {
+	startIndex, exists := g.nodes[start]
+	if !exists {
+		return
+	}
+	visited := make([]bool, len(g.nodes))
+	visited[startIndex] = true
+	queue := []int{startIndex}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if !visit(g.indexToNode[current]) {
+			return
+		}
+		for _, next := range g.adj[current] {
+			if !visited[next] {
+				visited[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+}
+
+// DFS 从start开始按有向边进行深度优先遍历，每个节点至多访问一次
+// 内部使用显式栈模拟递归，访问顺序与递归实现的先序遍历完全一致
+// （邻居按边的插入顺序展开），深链图也不会导致栈溢出；start不存在时不做任何操作
+func (g *Graph[T]) DFS(start T, visit func(node T)) {
+	startIndex, exists := g.nodes[start]
+	if !exists {
+		return
+	}
+	visited := make([]bool, len(g.nodes))
+	g.dfs(startIndex, visited, func(index int) {
+		visit(g.indexToNode[index])
+	}, nil)
+}
+
+// Walk 从start开始进行深度优先遍历，首次进入节点时调用pre，节点的所有后继处理完成后调用post
+// 每个节点至多进入一次，环不会导致无限递归；pre或post可为nil，start不存在时不做任何操作
+func (g *Graph[T]) Walk(start T, pre, post func(node T)) {
+	startIndex, exists := g.nodes[start]
+	if !exists {
+		return
+	}
+	var preIndex, postIndex func(index int)
+	if pre != nil {
+		preIndex = func(index int) { pre(g.indexToNode[index]) }
+	}
+	if post != nil {
+		postIndex = func(index int) { post(g.indexToNode[index]) }
+	}
+	g.dfs(startIndex, make([]bool, len(g.nodes)), preIndex, postIndex)
+}
+
+// dfsFrame 迭代DFS的栈帧，记录节点索引及下一个待展开的邻居位置
+type dfsFrame struct {
+	index int
+	next  int
+}
+
+// dfs 从start索引开始迭代深度优先遍历
+// pre在首次进入节点时调用，post在节点的所有后继处理完成后调用，二者均可为nil
+func (g *Graph[T]) dfs(start int, visited []bool, pre, post func(index int)) {
+	visited[start] = true
+	if pre != nil {
+		pre(start)
+	}
+	stack := []dfsFrame{{index: start}}
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		neighbors := g.adj[top.index]
+		if top.next < len(neighbors) {
+			next := neighbors[top.next]
+			top.next++
+			if !visited[next] {
+				visited[next] = true
+				if pre != nil {
+					pre(next)
+				}
+				stack = append(stack, dfsFrame{index: next})
+			}
+			continue
+		}
+		if post != nil {
+			post(top.index)
+		}
+		stack = stack[:len(stack)-1]
+	}
+}
+
+// NeighborsWithinK 按BFS发现顺序返回从node出发沿有向边至多k跳可到达的所有节点，不包含node自身
+// 每个节点只出现一次；k为1时结果为去重后的Neighbors（不含自环带来的node自身），
+// k小于等于0或node不存在时返回空切片
+func (g *Graph[T]) NeighborsWithinK(node T, k int) []T {
+	result := make([]T, 0)
+	start, exists := g.nodes[node]
+	if !exists || k <= 0 {
+		return result
+	}
+	dist := make([]int, len(g.nodes))
+	for i := range dist {
+		dist[i] = -1
+	}
+	dist[start] = 0
+	queue := []int{start}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if dist[current] == k {
+			continue
+		}
+		for _, next := range g.adj[current] {
+			if dist[next] == -1 {
+				dist[next] = dist[current] + 1
+				result = append(result, g.indexToNode[next])
+				queue = append(queue, next)
+			}
+		}
+	}
+	return result
+}
+
+// RandomWalk 从start开始进行随机游走，返回至多steps个节点组成的序列（包含start）
+// 每一步等概率地选择一条出边前进（平行边按重数计），到达没有出边的节点时提前结束；
+// start不存在或steps小于等于0时返回空切片；传入固定种子的rng可复现结果
+func (g *Graph[T]) RandomWalk(start T, steps int, rng *rand.Rand) []T {
+	current, exists := g.nodes[start]
+	if !exists || steps <= 0 {
+		return []T{}
+	}
+	walk := make([]T, 0, steps)
+	walk = append(walk, start)
+	for len(walk) < steps && len(g.adj[current]) > 0 {
+		current = g.adj[current][rng.IntN(len(g.adj[current]))]
+		walk = append(walk, g.indexToNode[current])
+	}
+	return walk
+}
+
+// bfsDist 从start索引执行BFS，返回每个节点索引的跳数距离，不可达的节点为-1
+func (g *Graph[T]) bfsDist(start int) []int {
+	dist := make([]int, len(g.nodes))
+	for i := range dist {
+		dist[i] = -1
+	}
+	dist[start] = 0
+	queue := []int{start}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, next := range g.adj[current] {
+			if dist[next] == -1 {
+				dist[next] = dist[current] + 1
+				queue = append(queue, next)
+			}
+		}
+	}
+	return dist
+}
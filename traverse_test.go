@@ -0,0 +1,169 @@
+package ggraph_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/nosusume/ggraph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBFSOrder(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "C")
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", "D")
+	graph.AddEdge("C", "D")
+	graph.AddEdge("D", "A") // 环
+
+	var visited []string
+	graph.BFS("A", func(node string) bool {
+		visited = append(visited, node)
+		return true
+	})
+	assert.Equal(t, []string{"A", "C", "B", "D"}, visited, "应按层级及边插入顺序访问，且不重复")
+}
+
+func TestBFSDisconnected(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	graph.AddEdge(1, 2)
+	graph.AddEdge(3, 4)
+
+	var visited []int
+	graph.BFS(1, func(node int) bool {
+		visited = append(visited, node)
+		return true
+	})
+	assert.Equal(t, []int{1, 2}, visited, "不应访问其他连通分量的节点")
+}
+
+func TestBFSEarlyStop(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	graph.AddEdge(1, 2)
+	graph.AddEdge(1, 3)
+	graph.AddEdge(2, 4)
+
+	var visited []int
+	graph.BFS(1, func(node int) bool {
+		visited = append(visited, node)
+		return node != 2
+	})
+	assert.Equal(t, []int{1, 2}, visited, "visit返回false后应停止遍历")
+}
+
+func TestBFSMissingStart(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	graph.AddEdge(1, 2)
+
+	called := false
+	graph.BFS(999, func(int) bool {
+		called = true
+		return true
+	})
+	assert.False(t, called, "起始节点不存在时不应访问任何节点")
+}
+
+func TestDFSOrder(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("A", "C")
+	graph.AddEdge("B", "D")
+	graph.AddEdge("D", "A") // 环
+	graph.AddEdge("C", "D")
+
+	var visited []string
+	graph.DFS("A", func(node string) {
+		visited = append(visited, node)
+	})
+	assert.Equal(t, []string{"A", "B", "D", "C"}, visited, "应与递归先序遍历顺序一致且不重复")
+}
+
+func TestDFSMissingStart(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	graph.AddEdge(1, 2)
+
+	called := false
+	graph.DFS(999, func(int) {
+		called = true
+	})
+	assert.False(t, called, "起始节点不存在时不应访问任何节点")
+}
+
+func TestDFSDeepChain(t *testing.T) {
+	const n = 5000
+	graph := ggraph.NewGraph[int]()
+	for i := 0; i < n-1; i++ {
+		graph.AddEdge(i, i+1)
+	}
+
+	count := 0
+	last := -1
+	graph.DFS(0, func(node int) {
+		count++
+		last = node
+	})
+	assert.Equal(t, n, count, "长链上应访问全部节点且不栈溢出")
+	assert.Equal(t, n-1, last, "最后访问的应为链尾节点")
+}
+
+func TestWalk(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("a", "b")
+	graph.AddEdge("a", "c")
+	graph.AddEdge("c", "a") // 环
+
+	var pre, post []string
+	graph.Walk("a", func(node string) {
+		pre = append(pre, node)
+	}, func(node string) {
+		post = append(post, node)
+	})
+	assert.Equal(t, []string{"a", "b", "c"}, pre, "先序应按进入顺序")
+	assert.Equal(t, []string{"b", "c", "a"}, post, "a的后序应在b和c之后")
+
+	count := 0
+	graph.Walk("a", nil, func(string) { count++ })
+	assert.Equal(t, 3, count, "pre为nil时应正常遍历")
+	graph.Walk("z", func(string) { count++ }, nil)
+	assert.Equal(t, 3, count, "起始节点不存在时不应访问任何节点")
+}
+
+func TestRandomWalk(t *testing.T) {
+	graph := ggraph.CompleteGraph(6)
+
+	walk := graph.RandomWalk(0, 20, rand.New(rand.NewPCG(3, 5)))
+	assert.Len(t, walk, 20, "没有死胡同时应走满steps个节点")
+	assert.Equal(t, 0, walk[0], "游走应从start开始")
+	for i := 0; i+1 < len(walk); i++ {
+		assert.True(t, graph.HasEdge(walk[i], walk[i+1]), "每一步都应沿出边前进: %d->%d", walk[i], walk[i+1])
+	}
+	assert.Equal(t, walk, graph.RandomWalk(0, 20, rand.New(rand.NewPCG(3, 5))), "相同种子应得到相同的游走")
+}
+
+func TestRandomWalkDeadEnd(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", "C")
+
+	rng := rand.New(rand.NewPCG(1, 2))
+	assert.Equal(t, []string{"A", "B", "C"}, graph.RandomWalk("A", 10, rng), "到达没有出边的节点时应提前结束")
+	assert.Equal(t, []string{"A", "B"}, graph.RandomWalk("A", 2, rng), "至多返回steps个节点")
+	assert.Empty(t, graph.RandomWalk("A", 0, rng), "steps为0时应返回空切片")
+	assert.Empty(t, graph.RandomWalk("X", 5, rng), "start不存在时应返回空切片")
+}
+
+func TestNeighborsWithinK(t *testing.T) {
+	graph := ggraph.NewGraph[int]()
+	for i := 0; i < 5; i++ {
+		graph.AddEdge(i, i+1)
+	}
+
+	assert.Equal(t, []int{2, 3}, graph.NeighborsWithinK(1, 2), "k=2应包含两跳内的节点")
+	assert.NotContains(t, graph.NeighborsWithinK(1, 2), 4, "k=2不应包含三跳外的节点")
+	assert.Equal(t, graph.Neighbors(1), graph.NeighborsWithinK(1, 1), "k=1应与Neighbors一致")
+	assert.Empty(t, graph.NeighborsWithinK(1, 0), "k=0应返回空切片")
+	assert.Empty(t, graph.NeighborsWithinK(9, 2), "节点不存在时应返回空切片")
+
+	graph.AddEdge(3, 1)
+	assert.Equal(t, []int{2, 3, 4, 5}, graph.NeighborsWithinK(1, 10), "经环回到起点时不应包含起点")
+}
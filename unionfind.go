@@ -0,0 +1,50 @@
+package ggraph
+
+// disjointSet 按秩合并并带路径压缩的并查集，元素为节点索引
+type disjointSet struct {
+	parent []int
+	rank   []int
+	// count 当前集合数量
+	count int
+}
+
+// newDisjointSet 创建包含n个单元素集合的并查集
+func newDisjointSet(n int) *disjointSet {
+	ds := &disjointSet{
+		parent: make([]int, n),
+		rank:   make([]int, n),
+		count:  n,
+	}
+	for i := range ds.parent {
+		ds.parent[i] = i
+	}
+	return ds
+}
+
+// find 返回x所在集合的代表元素
+func (ds *disjointSet) find(x int) int {
+	for ds.parent[x] != x {
+		ds.parent[x] = ds.parent[ds.parent[x]]
+		x = ds.parent[x]
+	}
+	return x
+}
+
+// union 合并x与y所在的集合，返回二者原本是否属于不同集合
+func (ds *disjointSet) union(x, y int) bool {
+	rootX, rootY := ds.find(x), ds.find(y)
+	if rootX == rootY {
+		return false
+	}
+	switch {
+	case ds.rank[rootX] < ds.rank[rootY]:
+		ds.parent[rootX] = rootY
+	case ds.rank[rootX] > ds.rank[rootY]:
+		ds.parent[rootY] = rootX
+	default:
+		ds.parent[rootY] = rootX
+		ds.rank[rootX]++
+	}
+	ds.count--
+	return true
+}
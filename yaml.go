@@ -0,0 +1,24 @@
+package ggraph
+
+import "gopkg.in/yaml.v3"
+
+// ToYAML 将图按ToDTO的布局（nodes/adj及可选的node_attrs、weights）序列化为YAML
+func (g *Graph[T]) ToYAML() ([]byte, error) {
+	return yaml.Marshal(g.ToDTO())
+}
+
+// NewGraphFromYAML 从ToYAML的输出创建新的泛型图，校验规则与UnmarshalJSON一致
+// 节点按YAML规则解码：整数解码为int、浮点数解码为float64，与JSON路径中数字一律为float64不同；
+// 序列和映射会被解码为不可比较的[]any和map[string]any，因此数组或结构体节点的图（如GridGraph）
+// 无法经此函数往返，遇到时返回错误
+func NewGraphFromYAML(data []byte) (*Graph[any], error) {
+	var decoded graphData[any]
+	if err := yaml.Unmarshal(data, &decoded); err != nil {
+		return nil, err
+	}
+	g := NewGraph[any]()
+	if err := g.load(decoded); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
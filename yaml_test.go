@@ -0,0 +1,62 @@
+package ggraph_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/nosusume/ggraph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestYAMLRoundTrip(t *testing.T) {
+	graph := ggraph.NewGraph[string]()
+	graph.AddEdge("A", "B")
+	graph.AddWeightedEdge("B", "C", 2.5)
+	graph.AddEdge("C", "A")
+	graph.AddNode("D")
+	graph.SetNodeAttr("D", "color", "red")
+
+	data, err := graph.ToYAML()
+	assert.NoError(t, err, "序列化不应出错")
+	assert.Contains(t, string(data), "nodes:", "YAML布局应与DTO一致")
+	assert.Contains(t, string(data), "adj:", "YAML布局应与DTO一致")
+
+	decoded, err := ggraph.NewGraphFromYAML(data)
+	assert.NoError(t, err, "反序列化不应出错")
+	assert.Equal(t, []any{"A", "B", "C", "D"}, decoded.Nodes(), "节点应按原顺序恢复")
+	weight, _ := decoded.EdgeWeight("B", "C")
+	assert.Equal(t, 2.5, weight, "边权重应保留")
+	value, _ := decoded.NodeAttr("D", "color")
+	assert.Equal(t, "red", value, "节点属性应保留")
+
+	jsonData, err := json.Marshal(graph)
+	assert.NoError(t, err, "JSON序列化不应出错")
+	var dto ggraph.GraphDTO
+	assert.NoError(t, json.Unmarshal(jsonData, &dto), "JSON反序列化不应出错")
+	assert.True(t, ggraph.NewGraphByDTO(&dto).Equal(decoded), "YAML与JSON路径得到的图应相等")
+	assert.Equal(t, dto.Weights, decoded.ToDTO().Weights, "YAML与JSON路径得到的权重应一致")
+}
+
+func TestNewGraphFromYAMLInvalid(t *testing.T) {
+	_, err := ggraph.NewGraphFromYAML([]byte("nodes: [A]\nadj: [[3]]\n"))
+	assert.Error(t, err, "邻居索引越界应返回错误")
+	_, err = ggraph.NewGraphFromYAML([]byte("nodes: [A, A]\n"))
+	assert.Error(t, err, "节点重复应返回错误")
+	_, err = ggraph.NewGraphFromYAML([]byte("nodes: {"))
+	assert.Error(t, err, "YAML格式错误应返回错误")
+}
+
+func TestNewGraphFromYAMLUncomparableNodes(t *testing.T) {
+	data, err := ggraph.GridGraph(2, 2).ToYAML()
+	assert.NoError(t, err, "序列化不应出错")
+
+	var decoded *ggraph.Graph[any]
+	assert.NotPanics(t, func() {
+		decoded, err = ggraph.NewGraphFromYAML(data)
+	}, "数组节点不应导致panic")
+	assert.Error(t, err, "包含数组节点时应返回错误")
+	assert.Nil(t, decoded, "出错时应返回nil")
+
+	_, err = ggraph.NewGraphFromYAML([]byte("nodes: [{name: a}]\nadj: [[]]\n"))
+	assert.Error(t, err, "包含映射节点时应返回错误")
+}